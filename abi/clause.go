@@ -0,0 +1,86 @@
+package abi
+
+import (
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+// CallBody holds the necessary information to call any contract function:
+// the contract address, the function signature and its arguments.
+type CallBody struct {
+	contractAddress, signature string
+	args                       []interface{}
+}
+
+// New creates and returns an empty instance of CallBody.
+func New() *CallBody {
+	return &CallBody{}
+}
+
+// AddContractAddress adds the address of the contract to be called.
+func (cb *CallBody) AddContractAddress(contractAddr string) *CallBody {
+	cb.contractAddress = contractAddr
+	return cb
+}
+
+// AddSignature adds the Solidity function signature to be called, e.g.
+// swapExactTokensForTokens(uint256,uint256,address[],address,uint256).
+func (cb *CallBody) AddSignature(signature string) *CallBody {
+	cb.signature = signature
+	return cb
+}
+
+// AddArguments adds the arguments of the function in the order of the
+// function signature.
+func (cb *CallBody) AddArguments(args ...interface{}) *CallBody {
+	cb.args = append(cb.args, args...)
+	return cb
+}
+
+// Build validates its underlying instance, encodes the calldata and then
+// creates the new instance of CallClause.
+func (cb *CallBody) Build() (*CallClause, error) {
+	if !utils.IsValidAddress(cb.contractAddress) {
+		return nil, utils.ErrContractAddress
+	}
+
+	method, err := ParseMethod(cb.signature)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := method.Pack(cb.args...)
+	if err != nil {
+		return nil, err
+	}
+	return &CallClause{CallBody: *cb, method: method, data: data}, nil
+}
+
+// CallClause represents an encoded contract function call. It implements the
+// clause.ClauseTransform interface, so it can be passed to clause.NewClause.
+type CallClause struct {
+	CallBody
+	method *Method
+	data   []byte
+}
+
+// GetTokenAddress returns the address of the contract to be called.
+func (cc *CallClause) GetTokenAddress() string {
+	return cc.contractAddress
+}
+
+// GetMethod returns the method of the contract function to be called.
+func (cc *CallClause) GetMethod() *Method {
+	return cc.method
+}
+
+// GetERCPayloadData returns the calldata of the contract function call. The given
+// method must be either the name or the canonical signature of the function.
+func (cc *CallClause) GetERCPayloadData(method string) ([]byte, error) {
+	if method != cc.method.Name && method != cc.method.Signature() {
+		return nil, utils.NewValidationError(utils.FieldMethod, method, utils.ErrMethodNotFound)
+	}
+
+	data := make([]byte, len(cc.data))
+	copy(data, cc.data)
+	return data, nil
+}
@@ -0,0 +1,72 @@
+package abi
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mirzazhar/golang-transfer-clause/clause"
+)
+
+func TestCreateCallClause(t *testing.T) {
+	callclause, err := New().
+		AddContractAddress(contractaddress).
+		AddSignature("transfer(address,uint256)").
+		AddArguments(address, 3).
+		Build()
+	if err != nil {
+		t.Errorf("cannot create callclause: %v", err)
+	}
+
+	expected, err := Encode("transfer(address,uint256)", address, 3)
+	if err != nil {
+		t.Errorf("cannot encode transfer: %v", err)
+	}
+
+	for _, method := range []string{"transfer", "transfer(address,uint256)"} {
+		payloaddata, err := callclause.GetERCPayloadData(method)
+		if err != nil {
+			t.Errorf("cannot create payload data for %s: %v", method, err)
+		}
+
+		if !reflect.DeepEqual(payloaddata, expected) {
+			t.Errorf("got %v, wanted %v", payloaddata, expected)
+		}
+	}
+
+	if _, err := callclause.GetERCPayloadData("approve"); err == nil {
+		t.Errorf("got %v, wanted an error for an undefined method", err)
+	}
+}
+
+func TestCreateCallClauseInvalid(t *testing.T) {
+	_, err := New().AddSignature("transfer(address,uint256)").AddArguments(address, 3).Build()
+	if err == nil {
+		t.Errorf("got %v, wanted an error for an invalid contract address", err)
+	}
+
+	_, err = New().AddContractAddress(contractaddress).AddSignature("transfer(address,uint256)").Build()
+	if err == nil {
+		t.Errorf("got %v, wanted an error for missing arguments", err)
+	}
+}
+
+func TestNewClause(t *testing.T) {
+	callclause, err := New().
+		AddContractAddress(contractaddress).
+		AddSignature("stake(uint256)").
+		AddArguments(1).
+		Build()
+	if err != nil {
+		t.Errorf("cannot create callclause: %v", err)
+	}
+
+	cl, err := clause.NewClause(callclause, "stake")
+	if err != nil {
+		t.Errorf("cannot create clause: %v", err)
+	}
+
+	expected := "a694fc3a0000000000000000000000000000000000000000000000000000000000000001"
+	if cl.GetToAddress() != contractaddress || cl.GetData() != expected {
+		t.Errorf("got %v, wanted %v", cl.GetData(), expected)
+	}
+}
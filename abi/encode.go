@@ -0,0 +1,265 @@
+package abi
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+var (
+	bigIntType    = reflect.TypeOf(big.Int{})
+	bigIntPtrType = reflect.TypeOf(&big.Int{})
+)
+
+// EncodeArguments encodes the given arguments according to the given types
+// without any method ID. The arguments are accepted as the following Go values:
+//   - uintN, intN, ufixedMxN and fixedMxN: *big.Int, big.Int, any Go integer or
+//     an integer string (decimal or 0x-prefixed hex); fixed point values are
+//     given as integers already scaled by their decimals
+//   - address: a 0x-prefixed hex string, [20]byte or a []byte of 20 bytes
+//   - bool: bool
+//   - bytesN and function: [N]byte or a []byte of N bytes
+//   - bytes: []byte; string: string
+//   - T[] and T[k]: any Go slice or array of values of T
+//   - tuples: []interface{} or a struct whose exported fields are in order
+func EncodeArguments(types []*Type, args ...interface{}) ([]byte, error) {
+	if len(types) != len(args) {
+		return nil, fmt.Errorf("%w: got %d, wanted %d", utils.ErrArgumentCount, len(args), len(types))
+	}
+
+	values := make([]reflect.Value, len(args))
+	for i, arg := range args {
+		values[i] = reflect.ValueOf(arg)
+	}
+	return encodeTuple(types, values)
+}
+
+// encodeTuple encodes the given values as a sequence of heads followed by the
+// tails of the dynamic values.
+func encodeTuple(types []*Type, values []reflect.Value) ([]byte, error) {
+	var headSize int
+	for _, typ := range types {
+		headSize += typ.headSize()
+	}
+
+	var head, tail []byte
+	for i, typ := range types {
+		encoded, err := encode(typ, values[i])
+		if err != nil {
+			return nil, err
+		}
+
+		if typ.IsDynamic() {
+			head = append(head, uintWord(big.NewInt(int64(headSize+len(tail))))...)
+			tail = append(tail, encoded...)
+		} else {
+			head = append(head, encoded...)
+		}
+	}
+	return append(head, tail...), nil
+}
+
+// encode encodes the given value as the given type.
+func encode(typ *Type, value reflect.Value) ([]byte, error) {
+	value = indirect(value)
+	if !value.IsValid() {
+		return nil, fmt.Errorf("%w: nil value for %s", utils.ErrArgumentType, typ)
+	}
+
+	switch typ.Kind {
+	case UintKind, IntKind, UfixedKind, FixedKind:
+		return encodeInteger(typ, value)
+	case AddressKind:
+		return encodeAddress(typ, value)
+	case BoolKind:
+		if value.Kind() != reflect.Bool {
+			return nil, argumentTypeError(typ, value)
+		}
+
+		word := make([]byte, 32)
+		if value.Bool() {
+			word[31] = 1
+		}
+		return word, nil
+	case FixedBytesKind, FunctionKind:
+		data, ok := toBytes(value)
+		if !ok || len(data) != typ.Size {
+			return nil, argumentTypeError(typ, value)
+		}
+		return utils.RightPadBytes(data, 32), nil
+	case BytesKind:
+		data, ok := toBytes(value)
+		if !ok {
+			return nil, argumentTypeError(typ, value)
+		}
+		return encodeBytes(data), nil
+	case StringKind:
+		if value.Kind() != reflect.String {
+			return nil, argumentTypeError(typ, value)
+		}
+		return encodeBytes([]byte(value.String())), nil
+	case SliceKind:
+		if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+			return nil, argumentTypeError(typ, value)
+		}
+
+		encoded, err := encodeElements(typ.Elem, value, value.Len())
+		if err != nil {
+			return nil, err
+		}
+		return append(uintWord(big.NewInt(int64(value.Len()))), encoded...), nil
+	case ArrayKind:
+		if (value.Kind() != reflect.Slice && value.Kind() != reflect.Array) || value.Len() != typ.Length {
+			return nil, argumentTypeError(typ, value)
+		}
+		return encodeElements(typ.Elem, value, typ.Length)
+	case TupleKind:
+		return encodeTupleValue(typ, value)
+	}
+	return nil, fmt.Errorf("%w: %s", utils.ErrABIType, typ)
+}
+
+// encodeElements encodes the elements of the given slice or array as a tuple
+// of the same element type.
+func encodeElements(elem *Type, value reflect.Value, length int) ([]byte, error) {
+	types := make([]*Type, length)
+	values := make([]reflect.Value, length)
+	for i := 0; i < length; i++ {
+		types[i] = elem
+		values[i] = value.Index(i)
+	}
+	return encodeTuple(types, values)
+}
+
+// encodeTupleValue encodes the given []interface{} or struct value as the given
+// tuple type.
+func encodeTupleValue(typ *Type, value reflect.Value) ([]byte, error) {
+	var values []reflect.Value
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			values = append(values, value.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			if value.Type().Field(i).IsExported() {
+				values = append(values, value.Field(i))
+			}
+		}
+	default:
+		return nil, argumentTypeError(typ, value)
+	}
+
+	if len(values) != len(typ.Components) {
+		return nil, fmt.Errorf("%w: got %d tuple components, wanted %d",
+			utils.ErrArgumentCount, len(values), len(typ.Components))
+	}
+	return encodeTuple(typ.Components, values)
+}
+
+// encodeInteger encodes the given integer value and validates it against the
+// range of the given type. Negative values are encoded in two's complement.
+func encodeInteger(typ *Type, value reflect.Value) ([]byte, error) {
+	integer, ok := toBigInt(value)
+	if !ok {
+		return nil, argumentTypeError(typ, value)
+	}
+
+	signed := typ.Kind == IntKind || typ.Kind == FixedKind
+	word, err := utils.NewInteger(integer, typ.Size, signed)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s is out of range of %s", utils.ErrArgumentType, integer, typ)
+	}
+	return word.Word(), nil
+}
+
+// encodeAddress encodes the given address string or bytes.
+func encodeAddress(typ *Type, value reflect.Value) ([]byte, error) {
+	if value.Kind() == reflect.String {
+		if !utils.IsValidAddress(value.String()) {
+			return nil, argumentTypeError(typ, value)
+		}
+
+		address, err := utils.AddresstoBytes(value.String())
+		if err != nil {
+			return nil, err
+		}
+		return utils.LeftPadBytes(address, 32), nil
+	}
+
+	address, ok := toBytes(value)
+	if !ok || len(address) != 20 {
+		return nil, argumentTypeError(typ, value)
+	}
+	return utils.LeftPadBytes(address, 32), nil
+}
+
+// encodeBytes encodes the given bytes with their length, right padded to a
+// multiple of 32 bytes.
+func encodeBytes(data []byte) []byte {
+	paddedLength := (len(data) + 31) / 32 * 32
+	return append(uintWord(big.NewInt(int64(len(data)))), utils.RightPadBytes(data, paddedLength)...)
+}
+
+// uintWord converts the given non-negative integer into a word of 32 bytes.
+func uintWord(integer *big.Int) []byte {
+	return utils.LeftPadBytes(integer.Bytes(), 32)
+}
+
+// indirect dereferences the given interfaces and pointers, except *big.Int.
+func indirect(value reflect.Value) reflect.Value {
+	for value.IsValid() && value.Type() != bigIntPtrType &&
+		(value.Kind() == reflect.Interface || value.Kind() == reflect.Ptr) {
+		if value.IsNil() {
+			return reflect.Value{}
+		}
+		value = value.Elem()
+	}
+	return value
+}
+
+// toBigInt converts the given integer value into a big integer.
+func toBigInt(value reflect.Value) (*big.Int, bool) {
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return big.NewInt(value.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return new(big.Int).SetUint64(value.Uint()), true
+	case reflect.String:
+		return new(big.Int).SetString(value.String(), 0)
+	}
+
+	switch value.Type() {
+	case bigIntPtrType:
+		if value.IsNil() {
+			return nil, false
+		}
+		return value.Interface().(*big.Int), true
+	case bigIntType:
+		integer := value.Interface().(big.Int)
+		return &integer, true
+	}
+	return nil, false
+}
+
+// toBytes converts the given byte slice or byte array into bytes.
+func toBytes(value reflect.Value) ([]byte, bool) {
+	if (value.Kind() != reflect.Slice && value.Kind() != reflect.Array) ||
+		value.Type().Elem().Kind() != reflect.Uint8 {
+		return nil, false
+	}
+
+	data := make([]byte, value.Len())
+	for i := range data {
+		data[i] = byte(value.Index(i).Uint())
+	}
+	return data, true
+}
+
+// argumentTypeError creates the error of a value that cannot be encoded as the
+// given type.
+func argumentTypeError(typ *Type, value reflect.Value) error {
+	return fmt.Errorf("%w: %s as %s", utils.ErrArgumentType, value.Type(), typ)
+}
@@ -0,0 +1,117 @@
+package abi
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+var (
+	address         string = "0x27d22890587cfada7fec247c5180d73de6c670c4"
+	contractaddress string = "0xf6fe970533fe5C63d196139B14522Eb2956f8621"
+	fromaddress     string = "0x0bf4A8E0D09C3B16Bb6B90362Bc4218589b0a567"
+)
+
+func TestEncodeSwap(t *testing.T) {
+	payloaddata, err := Encode("swapExactTokensForTokens(uint256,uint256,address[],address,uint256)",
+		big.NewInt(1000), "990", []string{address, contractaddress}, fromaddress, uint64(1700000000))
+	if err != nil {
+		t.Errorf("cannot encode swapExactTokensForTokens: %v", err)
+	}
+
+	hexvaluepayload := hex.EncodeToString(payloaddata)
+	expected := "38ed1739" +
+		"00000000000000000000000000000000000000000000000000000000000003e8" +
+		"00000000000000000000000000000000000000000000000000000000000003de" +
+		"00000000000000000000000000000000000000000000000000000000000000a0" +
+		"0000000000000000000000000bf4a8e0d09c3b16bb6b90362bc4218589b0a567" +
+		"000000000000000000000000000000000000000000000000000000006553f100" +
+		"0000000000000000000000000000000000000000000000000000000000000002" +
+		"00000000000000000000000027d22890587cfada7fec247c5180d73de6c670c4" +
+		"000000000000000000000000f6fe970533fe5c63d196139b14522eb2956f8621"
+	if hexvaluepayload != expected {
+		t.Errorf("got %v, wanted %v", hexvaluepayload, expected)
+	}
+}
+
+func TestEncodeNested(t *testing.T) {
+	type item struct {
+		Account string
+		Amount  *big.Int
+	}
+
+	payloaddata, err := Encode("f(int8,bytes3,string,uint16[2][],(bool,bytes,(address,int256)[]))",
+		int8(-2), [3]byte{1, 2, 3}, "hello", [][2]uint16{{1, 2}, {3, 4}},
+		[]interface{}{true, []byte{0xca, 0xfe}, []item{{address, big.NewInt(-1)}}})
+	if err != nil {
+		t.Errorf("cannot encode nested arguments: %v", err)
+	}
+
+	hexvaluepayload := hex.EncodeToString(payloaddata)
+	expected := "620c90ae" +
+		"fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe" +
+		"0102030000000000000000000000000000000000000000000000000000000000" +
+		"00000000000000000000000000000000000000000000000000000000000000a0" +
+		"00000000000000000000000000000000000000000000000000000000000000e0" +
+		"0000000000000000000000000000000000000000000000000000000000000180" +
+		"0000000000000000000000000000000000000000000000000000000000000005" +
+		"68656c6c6f000000000000000000000000000000000000000000000000000000" +
+		"0000000000000000000000000000000000000000000000000000000000000002" +
+		"0000000000000000000000000000000000000000000000000000000000000001" +
+		"0000000000000000000000000000000000000000000000000000000000000002" +
+		"0000000000000000000000000000000000000000000000000000000000000003" +
+		"0000000000000000000000000000000000000000000000000000000000000004" +
+		"0000000000000000000000000000000000000000000000000000000000000001" +
+		"0000000000000000000000000000000000000000000000000000000000000060" +
+		"00000000000000000000000000000000000000000000000000000000000000a0" +
+		"0000000000000000000000000000000000000000000000000000000000000002" +
+		"cafe000000000000000000000000000000000000000000000000000000000000" +
+		"0000000000000000000000000000000000000000000000000000000000000001" +
+		"00000000000000000000000027d22890587cfada7fec247c5180d73de6c670c4" +
+		"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"
+	if hexvaluepayload != expected {
+		t.Errorf("got %v, wanted %v", hexvaluepayload, expected)
+	}
+}
+
+func TestEncodeERC20Transfer(t *testing.T) {
+	payloaddata, err := Encode("transfer(address,uint256)", address, "50000000000000000000")
+	if err != nil {
+		t.Errorf("cannot encode transfer: %v", err)
+	}
+
+	hexvaluepayload := hex.EncodeToString(payloaddata)
+	expected := "a9059cbb00000000000000000000000027d22890587cfada7fec247c5180d73de6c670c4000000000000000000000000000000000000000000000002b5e3af16b1880000"
+	if hexvaluepayload != expected {
+		t.Errorf("got %v, wanted %v", hexvaluepayload, expected)
+	}
+}
+
+func TestEncodeInvalid(t *testing.T) {
+	tests := []struct {
+		signature string
+		args      []interface{}
+		err       error
+	}{
+		{"transfer(address,uint256)", []interface{}{address}, utils.ErrArgumentCount},
+		{"transfer(address,uint256)", []interface{}{"0x3", 1}, utils.ErrArgumentType},
+		{"transfer(address,uint256)", []interface{}{address, -1}, utils.ErrArgumentType},
+		{"f(uint8)", []interface{}{256}, utils.ErrArgumentType},
+		{"f(int8)", []interface{}{-129}, utils.ErrArgumentType},
+		{"f(bytes2)", []interface{}{[]byte{1, 2, 3}}, utils.ErrArgumentType},
+		{"f(bool)", []interface{}{"true"}, utils.ErrArgumentType},
+		{"f(uint256[2])", []interface{}{[]int{1}}, utils.ErrArgumentType},
+		{"f((uint256,bool))", []interface{}{[]interface{}{1}}, utils.ErrArgumentCount},
+		{"f(uint256)", []interface{}{nil}, utils.ErrArgumentType},
+	}
+
+	for _, test := range tests {
+		_, err := Encode(test.signature, test.args...)
+		if !errors.Is(err, test.err) {
+			t.Errorf("%s: got %v, wanted %v", test.signature, err, test.err)
+		}
+	}
+}
@@ -0,0 +1,212 @@
+package abi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+// ABI holds the functions of a contract as described by its Solidity-compiler
+// JSON ABI. Overloaded functions are kept under the same name.
+type ABI struct {
+	Methods map[string][]*Method
+}
+
+// argument describes a function input within the JSON ABI.
+type argument struct {
+	Name       string     `json:"name"`
+	Type       string     `json:"type"`
+	Components []argument `json:"components"`
+}
+
+// entry describes a function, event, error or constructor within the JSON ABI.
+type entry struct {
+	Type   string     `json:"type"`
+	Name   string     `json:"name"`
+	Inputs []argument `json:"inputs"`
+}
+
+// LoadJSON reads and parses the JSON ABI from the given reader.
+func LoadJSON(r io.Reader) (*ABI, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return ParseJSON(data)
+}
+
+// ParseJSON parses the given JSON ABI. Besides the plain ABI array, compiler
+// artifacts (e.g. Hardhat or Truffle) that hold the ABI in an "abi" field are
+// accepted as well.
+func ParseJSON(data []byte) (*ABI, error) {
+	var entries []entry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		var artifact struct {
+			ABI []entry `json:"abi"`
+		}
+		if err := json.Unmarshal(data, &artifact); err != nil {
+			return nil, fmt.Errorf("%w: %v", utils.ErrABIFormat, err)
+		} else if artifact.ABI == nil {
+			return nil, fmt.Errorf("%w: no abi array found", utils.ErrABIFormat)
+		}
+		entries = artifact.ABI
+	}
+
+	abi := &ABI{Methods: make(map[string][]*Method)}
+	for _, e := range entries {
+		// the type of an entry defaults to function when omitted.
+		if e.Type != "function" && e.Type != "" {
+			continue
+		}
+
+		method := &Method{Name: e.Name}
+		if !isValidName(method.Name) {
+			return nil, fmt.Errorf("%w: invalid function name %q", utils.ErrABIFormat, e.Name)
+		}
+
+		for _, input := range e.Inputs {
+			typ, err := NewType(input.canonicalType())
+			if err != nil {
+				return nil, err
+			}
+			method.Inputs = append(method.Inputs, typ)
+		}
+		abi.Methods[method.Name] = append(abi.Methods[method.Name], method)
+	}
+	return abi, nil
+}
+
+// canonicalType returns the type of the argument with its tuple components
+// spelled out, e.g. tuple[] becomes (address,uint256)[].
+func (arg argument) canonicalType() string {
+	if !strings.HasPrefix(arg.Type, "tuple") {
+		return arg.Type
+	}
+
+	components := make([]string, len(arg.Components))
+	for i, component := range arg.Components {
+		components[i] = component.canonicalType()
+	}
+	return "(" + strings.Join(components, ",") + ")" + strings.TrimPrefix(arg.Type, "tuple")
+}
+
+// Method returns the function of the given name that accepts the given arguments.
+// Overloaded functions are resolved by the number of arguments and then by
+// whether the arguments can be encoded as their input types. The name can also
+// be a function signature to select an overload explicitly.
+func (a *ABI) Method(name string, args ...interface{}) (*Method, error) {
+	if strings.Contains(name, "(") {
+		return a.methodBySignature(name)
+	}
+
+	methods, ok := a.Methods[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", utils.ErrMethodNotFound, name)
+	}
+
+	var matched []*Method
+	var err error = fmt.Errorf("%w: no overload of %s takes %d arguments", utils.ErrArgumentCount, name, len(args))
+	for _, method := range methods {
+		if len(method.Inputs) != len(args) {
+			continue
+		}
+
+		if _, encodeErr := EncodeArguments(method.Inputs, args...); encodeErr != nil {
+			err = encodeErr
+			continue
+		}
+		matched = append(matched, method)
+	}
+
+	switch len(matched) {
+	case 0:
+		return nil, err
+	case 1:
+		return matched[0], nil
+	}
+
+	signatures := make([]string, len(matched))
+	for i, method := range matched {
+		signatures[i] = method.Signature()
+	}
+	return nil, fmt.Errorf("%w: %s", utils.ErrAmbiguousMethod, strings.Join(signatures, ", "))
+}
+
+// methodBySignature returns the function of the given signature.
+func (a *ABI) methodBySignature(signature string) (*Method, error) {
+	parsed, err := ParseMethod(signature)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, method := range a.Methods[parsed.Name] {
+		if method.Signature() == parsed.Signature() {
+			return method, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", utils.ErrMethodNotFound, signature)
+}
+
+// Contract binds the ABI to a deployed contract. It implements the
+// clause.ClauseTransform interface, so clause.NewClause(contract, "stake")
+// builds the clause of any function once its arguments have been added.
+type Contract struct {
+	contractAddress string
+	abi             *ABI
+	args            map[string][]interface{}
+}
+
+// NewContract creates an instance of Contract for the given contract address
+// and its ABI.
+func NewContract(contractAddr string, abi *ABI) (*Contract, error) {
+	if !utils.IsValidAddress(contractAddr) {
+		return nil, utils.ErrContractAddress
+	}
+
+	return &Contract{
+		contractAddress: contractAddr,
+		abi:             abi,
+		args:            make(map[string][]interface{}),
+	}, nil
+}
+
+// AddArguments adds the arguments to be used whenever the payload of the given
+// method, i.e., a function name or signature, is requested.
+func (c *Contract) AddArguments(method string, args ...interface{}) *Contract {
+	c.args[method] = args
+	return c
+}
+
+// GetTokenAddress returns the address of the contract.
+func (c *Contract) GetTokenAddress() string {
+	return c.contractAddress
+}
+
+// GetERCPayloadData returns the calldata of the given method using the arguments
+// added for it.
+func (c *Contract) GetERCPayloadData(method string) ([]byte, error) {
+	args := c.args[method]
+	m, err := c.abi.Method(method, args...)
+	if err != nil {
+		return nil, err
+	}
+	return m.Pack(args...)
+}
+
+// Call creates the CallClause of the given method, i.e., a function name or
+// signature, with the given arguments.
+func (c *Contract) Call(method string, args ...interface{}) (*CallClause, error) {
+	m, err := c.abi.Method(method, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return New().
+		AddContractAddress(c.contractAddress).
+		AddSignature(m.Signature()).
+		AddArguments(args...).
+		Build()
+}
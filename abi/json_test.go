@@ -0,0 +1,141 @@
+package abi
+
+import (
+	"encoding/hex"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mirzazhar/golang-transfer-clause/clause"
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+var stakingABI = `[
+	{"type":"constructor","inputs":[{"name":"token","type":"address"}]},
+	{"type":"event","name":"Staked","inputs":[{"name":"user","type":"address","indexed":true}]},
+	{"type":"function","name":"stake","inputs":[{"name":"amount","type":"uint256"}],"outputs":[],"stateMutability":"nonpayable"},
+	{"type":"function","name":"stake","inputs":[{"name":"amount","type":"uint256"},{"name":"beneficiary","type":"address"}],"outputs":[]},
+	{"type":"function","name":"stakeFor","inputs":[{"name":"beneficiary","type":"address"}]},
+	{"type":"function","name":"stakeFor","inputs":[{"name":"note","type":"string"}]},
+	{"type":"function","name":"claim","inputs":[{"name":"claims","type":"tuple[]","components":[
+		{"name":"account","type":"address"},
+		{"name":"amounts","type":"tuple","components":[{"name":"value","type":"uint256"},{"name":"proof","type":"bytes32[]"}]}
+	]}]}
+]`
+
+func TestParseJSON(t *testing.T) {
+	abi, err := LoadJSON(strings.NewReader(stakingABI))
+	if err != nil {
+		t.Errorf("cannot load abi: %v", err)
+	}
+
+	expected := map[string][]string{
+		"stake":    {"stake(uint256)", "stake(uint256,address)"},
+		"stakeFor": {"stakeFor(address)", "stakeFor(string)"},
+		"claim":    {"claim((address,(uint256,bytes32[]))[])"},
+	}
+
+	signatures := make(map[string][]string)
+	for name, methods := range abi.Methods {
+		for _, method := range methods {
+			signatures[name] = append(signatures[name], method.Signature())
+		}
+	}
+
+	if !reflect.DeepEqual(signatures, expected) {
+		t.Errorf("got %v, wanted %v", signatures, expected)
+	}
+}
+
+func TestParseJSONArtifact(t *testing.T) {
+	abi, err := ParseJSON([]byte(`{"contractName":"Staking","abi":` + stakingABI + `}`))
+	if err != nil {
+		t.Errorf("cannot parse artifact: %v", err)
+	}
+
+	if len(abi.Methods["stake"]) != 2 {
+		t.Errorf("got %v, wanted %v", len(abi.Methods["stake"]), 2)
+	}
+
+	for _, invalid := range []string{`{}`, `{"abi":`, `[{"type":"function","name":"f","inputs":[{"type":"uint7"}]}]`} {
+		if _, err := ParseJSON([]byte(invalid)); err == nil {
+			t.Errorf("got %v, wanted an error for %s", err, invalid)
+		}
+	}
+}
+
+func TestMethodOverloads(t *testing.T) {
+	abi, err := ParseJSON([]byte(stakingABI))
+	if err != nil {
+		t.Errorf("cannot parse abi: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		args      []interface{}
+		signature string
+		err       error
+	}{
+		{"stake", []interface{}{1}, "stake(uint256)", nil},
+		{"stake", []interface{}{1, address}, "stake(uint256,address)", nil},
+		{"stakeFor", []interface{}{"early bird"}, "stakeFor(string)", nil},
+		{"stakeFor", []interface{}{address}, "", utils.ErrAmbiguousMethod},
+		{"stakeFor(address)", []interface{}{address}, "stakeFor(address)", nil},
+		{"stake", []interface{}{1, 2, 3}, "", utils.ErrArgumentCount},
+		{"stake", []interface{}{"one"}, "", utils.ErrArgumentType},
+		{"unstake", nil, "", utils.ErrMethodNotFound},
+	}
+
+	for _, test := range tests {
+		method, err := abi.Method(test.name, test.args...)
+		if test.err != nil {
+			if !errors.Is(err, test.err) {
+				t.Errorf("%s: got %v, wanted %v", test.name, err, test.err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: cannot resolve method: %v", test.name, err)
+		} else if method.Signature() != test.signature {
+			t.Errorf("got %v, wanted %v", method.Signature(), test.signature)
+		}
+	}
+}
+
+func TestContractNewClause(t *testing.T) {
+	abi, err := ParseJSON([]byte(stakingABI))
+	if err != nil {
+		t.Errorf("cannot parse abi: %v", err)
+	}
+
+	contract, err := NewContract(contractaddress, abi)
+	if err != nil {
+		t.Errorf("cannot create contract: %v", err)
+	}
+
+	cl, err := clause.NewClause(contract.AddArguments("stake", 1), "stake")
+	if err != nil {
+		t.Errorf("cannot create clause: %v", err)
+	}
+
+	expected := "a694fc3a0000000000000000000000000000000000000000000000000000000000000001"
+	if cl.GetToAddress() != contractaddress || cl.GetData() != expected {
+		t.Errorf("got %v, wanted %v", cl.GetData(), expected)
+	}
+
+	callclause, err := contract.Call("stake", 1)
+	if err != nil {
+		t.Errorf("cannot create callclause: %v", err)
+	}
+
+	payloaddata, err := callclause.GetERCPayloadData("stake")
+	if err != nil || hex.EncodeToString(payloaddata) != expected {
+		t.Errorf("got %v, wanted %v", hex.EncodeToString(payloaddata), expected)
+	}
+
+	if _, err := NewContract("0x3", abi); err == nil {
+		t.Errorf("got %v, wanted an error for an invalid contract address", err)
+	}
+}
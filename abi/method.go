@@ -0,0 +1,82 @@
+package abi
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+// Method describes a contract function by its name and input types.
+type Method struct {
+	Name   string
+	Inputs []*Type
+}
+
+// ParseMethod parses the given Solidity function signature, e.g.
+// swapExactTokensForTokens(uint256,uint256,address[],address,uint256),
+// and creates its Method.
+func ParseMethod(signature string) (*Method, error) {
+	signature = strings.TrimSpace(signature)
+	open := strings.Index(signature, "(")
+	if open <= 0 || !strings.HasSuffix(signature, ")") {
+		return nil, fmt.Errorf("%w: %s", utils.ErrMethodSignature, signature)
+	}
+
+	name := signature[:open]
+	if !isValidName(name) {
+		return nil, fmt.Errorf("%w: %s", utils.ErrMethodSignature, signature)
+	}
+
+	inputs, err := parseTypes(signature[open+1 : len(signature)-1])
+	if err != nil {
+		return nil, err
+	}
+	return &Method{Name: name, Inputs: inputs}, nil
+}
+
+// isValidName validates the given Solidity identifier.
+func isValidName(name string) bool {
+	for i, char := range name {
+		switch {
+		case char == '_' || char == '$':
+		case char >= 'a' && char <= 'z', char >= 'A' && char <= 'Z':
+		case char >= '0' && char <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+	return name != ""
+}
+
+// Signature returns the canonical signature of the method.
+func (m *Method) Signature() string {
+	return m.Name + "(" + typesString(m.Inputs) + ")"
+}
+
+// ID returns the method ID of 4 bytes.
+func (m *Method) ID() [4]byte {
+	return utils.MethodID(m.Signature())
+}
+
+// Pack encodes the given arguments according to the method inputs and
+// returns them prefixed with the method ID, i.e., the calldata.
+func (m *Method) Pack(args ...interface{}) ([]byte, error) {
+	encoded, err := EncodeArguments(m.Inputs, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	methodID := m.ID()
+	return append(methodID[:], encoded...), nil
+}
+
+// Encode parses the given function signature and encodes the given arguments
+// into the calldata.
+func Encode(signature string, args ...interface{}) ([]byte, error) {
+	method, err := ParseMethod(signature)
+	if err != nil {
+		return nil, err
+	}
+	return method.Pack(args...)
+}
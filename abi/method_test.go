@@ -0,0 +1,47 @@
+package abi
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestParseMethod(t *testing.T) {
+	method, err := ParseMethod("swapExactTokensForTokens(uint,uint256,address[],address,uint256)")
+	if err != nil {
+		t.Errorf("cannot parse method: %v", err)
+	}
+
+	expected := "swapExactTokensForTokens(uint256,uint256,address[],address,uint256)"
+	if method.Signature() != expected {
+		t.Errorf("got %v, wanted %v", method.Signature(), expected)
+	}
+
+	id := method.ID()
+	if hex.EncodeToString(id[:]) != "38ed1739" {
+		t.Errorf("got %v, wanted %v", hex.EncodeToString(id[:]), "38ed1739")
+	}
+}
+
+func TestParseMethodWithoutInputs(t *testing.T) {
+	method, err := ParseMethod("totalSupply()")
+	if err != nil {
+		t.Errorf("cannot parse method: %v", err)
+	}
+
+	if len(method.Inputs) != 0 || method.Signature() != "totalSupply()" {
+		t.Errorf("got %v, wanted %v", method.Signature(), "totalSupply()")
+	}
+}
+
+func TestParseMethodInvalid(t *testing.T) {
+	signatures := []string{
+		"", "transfer", "(address)", "1transfer(address)", "transfer(address",
+		"transfer(address,)", "trans fer(address)", "transfer(uint7)",
+	}
+
+	for _, signature := range signatures {
+		if _, err := ParseMethod(signature); err == nil {
+			t.Errorf("got %v, wanted an error for %s", err, signature)
+		}
+	}
+}
@@ -0,0 +1,296 @@
+package abi
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+// Kind represents the family of a Solidity ABI type.
+type Kind int
+
+// Solidity ABI type families.
+const (
+	UintKind Kind = iota
+	IntKind
+	UfixedKind
+	FixedKind
+	AddressKind
+	BoolKind
+	FixedBytesKind
+	FunctionKind
+	BytesKind
+	StringKind
+	SliceKind
+	ArrayKind
+	TupleKind
+)
+
+// Type describes a Solidity ABI type such as uint256, bytes32, address[]
+// or (address,uint256)[2].
+type Type struct {
+	Kind Kind
+
+	// Size holds the number of bits of the integer and fixed point types and
+	// the number of bytes of the fixed bytes types.
+	Size int
+
+	// Decimals holds the number of decimals of the fixed point types.
+	Decimals int
+
+	// Length holds the number of elements of the fixed array types.
+	Length int
+
+	// Elem holds the element type of the array types.
+	Elem *Type
+
+	// Components holds the component types of the tuple types.
+	Components []*Type
+}
+
+// NewType parses the given Solidity type and creates its Type. The aliases
+// uint, int, ufixed, fixed and byte are resolved to their canonical types.
+func NewType(typ string) (*Type, error) {
+	typ = strings.TrimSpace(typ)
+	if typ == "" {
+		return nil, fmt.Errorf("%w: empty type", utils.ErrABIType)
+	}
+
+	// array types, the last suffix belongs to the outermost array.
+	if strings.HasSuffix(typ, "]") {
+		open := strings.LastIndex(typ, "[")
+		if open <= 0 {
+			return nil, fmt.Errorf("%w: %s", utils.ErrABIType, typ)
+		}
+
+		elem, err := NewType(typ[:open])
+		if err != nil {
+			return nil, err
+		}
+
+		size := typ[open+1 : len(typ)-1]
+		if size == "" {
+			return &Type{Kind: SliceKind, Elem: elem}, nil
+		}
+
+		length, err := strconv.Atoi(size)
+		if err != nil || length <= 0 {
+			return nil, fmt.Errorf("%w: %s", utils.ErrABIType, typ)
+		}
+		return &Type{Kind: ArrayKind, Elem: elem, Length: length}, nil
+	}
+
+	// tuple types.
+	if strings.HasPrefix(typ, "(") {
+		if !strings.HasSuffix(typ, ")") {
+			return nil, fmt.Errorf("%w: %s", utils.ErrABIType, typ)
+		}
+
+		components, err := parseTypes(typ[1 : len(typ)-1])
+		if err != nil {
+			return nil, err
+		}
+		return &Type{Kind: TupleKind, Components: components}, nil
+	}
+	return newElementaryType(typ)
+}
+
+// newElementaryType parses the non-composite Solidity types.
+func newElementaryType(typ string) (*Type, error) {
+	switch typ {
+	case "address":
+		return &Type{Kind: AddressKind}, nil
+	case "bool":
+		return &Type{Kind: BoolKind}, nil
+	case "string":
+		return &Type{Kind: StringKind}, nil
+	case "bytes":
+		return &Type{Kind: BytesKind}, nil
+	case "function":
+		return &Type{Kind: FunctionKind, Size: 24}, nil
+	case "byte":
+		return &Type{Kind: FixedBytesKind, Size: 1}, nil
+	case "uint":
+		return &Type{Kind: UintKind, Size: 256}, nil
+	case "int":
+		return &Type{Kind: IntKind, Size: 256}, nil
+	case "ufixed":
+		return &Type{Kind: UfixedKind, Size: 128, Decimals: 18}, nil
+	case "fixed":
+		return &Type{Kind: FixedKind, Size: 128, Decimals: 18}, nil
+	}
+
+	switch {
+	case strings.HasPrefix(typ, "uint"):
+		return newIntegerType(UintKind, typ, typ[len("uint"):])
+	case strings.HasPrefix(typ, "int"):
+		return newIntegerType(IntKind, typ, typ[len("int"):])
+	case strings.HasPrefix(typ, "ufixed"):
+		return newFixedPointType(UfixedKind, typ, typ[len("ufixed"):])
+	case strings.HasPrefix(typ, "fixed"):
+		return newFixedPointType(FixedKind, typ, typ[len("fixed"):])
+	case strings.HasPrefix(typ, "bytes"):
+		size, err := strconv.Atoi(typ[len("bytes"):])
+		if err != nil || size < 1 || size > 32 {
+			return nil, fmt.Errorf("%w: %s", utils.ErrABIType, typ)
+		}
+		return &Type{Kind: FixedBytesKind, Size: size}, nil
+	}
+	return nil, fmt.Errorf("%w: %s", utils.ErrABIType, typ)
+}
+
+// newIntegerType parses the bits of the intN and uintN types.
+func newIntegerType(kind Kind, typ, bits string) (*Type, error) {
+	size, err := strconv.Atoi(bits)
+	if err != nil || !isValidBits(size) {
+		return nil, fmt.Errorf("%w: %s", utils.ErrABIType, typ)
+	}
+	return &Type{Kind: kind, Size: size}, nil
+}
+
+// newFixedPointType parses the bits and decimals of the fixedMxN and ufixedMxN types.
+func newFixedPointType(kind Kind, typ, bits string) (*Type, error) {
+	parts := strings.Split(bits, "x")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("%w: %s", utils.ErrABIType, typ)
+	}
+
+	size, err := strconv.Atoi(parts[0])
+	if err != nil || !isValidBits(size) {
+		return nil, fmt.Errorf("%w: %s", utils.ErrABIType, typ)
+	}
+
+	decimals, err := strconv.Atoi(parts[1])
+	if err != nil || decimals < 0 || decimals > 80 {
+		return nil, fmt.Errorf("%w: %s", utils.ErrABIType, typ)
+	}
+	return &Type{Kind: kind, Size: size, Decimals: decimals}, nil
+}
+
+// isValidBits validates the number of bits of the integer and fixed point types.
+func isValidBits(size int) bool {
+	return size >= 8 && size <= 256 && size%8 == 0
+}
+
+// parseTypes parses the comma separated list of types. Commas inside of the
+// nested tuples are not treated as separators.
+func parseTypes(types string) ([]*Type, error) {
+	if strings.TrimSpace(types) == "" {
+		return nil, nil
+	}
+
+	var parsed []*Type
+	var depth, start int
+	for i, char := range types {
+		switch char {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("%w: %s", utils.ErrABIType, types)
+			}
+		case ',':
+			if depth == 0 {
+				typ, err := NewType(types[start:i])
+				if err != nil {
+					return nil, err
+				}
+				parsed = append(parsed, typ)
+				start = i + 1
+			}
+		}
+	}
+
+	if depth != 0 {
+		return nil, fmt.Errorf("%w: %s", utils.ErrABIType, types)
+	}
+
+	typ, err := NewType(types[start:])
+	if err != nil {
+		return nil, err
+	}
+	return append(parsed, typ), nil
+}
+
+// String returns the canonical form of the type as used by method signatures.
+func (t *Type) String() string {
+	switch t.Kind {
+	case UintKind:
+		return "uint" + strconv.Itoa(t.Size)
+	case IntKind:
+		return "int" + strconv.Itoa(t.Size)
+	case UfixedKind:
+		return fmt.Sprintf("ufixed%dx%d", t.Size, t.Decimals)
+	case FixedKind:
+		return fmt.Sprintf("fixed%dx%d", t.Size, t.Decimals)
+	case AddressKind:
+		return "address"
+	case BoolKind:
+		return "bool"
+	case FixedBytesKind:
+		return "bytes" + strconv.Itoa(t.Size)
+	case FunctionKind:
+		return "function"
+	case BytesKind:
+		return "bytes"
+	case StringKind:
+		return "string"
+	case SliceKind:
+		return t.Elem.String() + "[]"
+	case ArrayKind:
+		return t.Elem.String() + "[" + strconv.Itoa(t.Length) + "]"
+	case TupleKind:
+		return "(" + typesString(t.Components) + ")"
+	}
+	return ""
+}
+
+// typesString joins the canonical form of the given types with commas.
+func typesString(types []*Type) string {
+	names := make([]string, len(types))
+	for i, typ := range types {
+		names[i] = typ.String()
+	}
+	return strings.Join(names, ",")
+}
+
+// IsDynamic reports whether the encoding of the type is placed in the tail
+// of its enclosing tuple or arguments.
+func (t *Type) IsDynamic() bool {
+	switch t.Kind {
+	case BytesKind, StringKind, SliceKind:
+		return true
+	case ArrayKind:
+		return t.Elem.IsDynamic()
+	case TupleKind:
+		for _, component := range t.Components {
+			if component.IsDynamic() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// headSize returns the number of bytes the type occupies in the head of its
+// enclosing tuple or arguments.
+func (t *Type) headSize() int {
+	if t.IsDynamic() {
+		return 32
+	}
+
+	switch t.Kind {
+	case ArrayKind:
+		return t.Length * t.Elem.headSize()
+	case TupleKind:
+		var size int
+		for _, component := range t.Components {
+			size += component.headSize()
+		}
+		return size
+	}
+	return 32
+}
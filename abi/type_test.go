@@ -0,0 +1,74 @@
+package abi
+
+import (
+	"testing"
+)
+
+func TestNewType(t *testing.T) {
+	types := map[string]string{
+		"uint":                        "uint256",
+		"int":                         "int256",
+		"byte":                        "bytes1",
+		"fixed":                       "fixed128x18",
+		"ufixed":                      "ufixed128x18",
+		"uint8":                       "uint8",
+		"bytes32":                     "bytes32",
+		"address[]":                   "address[]",
+		"uint16[2][]":                 "uint16[2][]",
+		"(uint,(address,bytes)[])[3]": "(uint256,(address,bytes)[])[3]",
+		"function":                    "function",
+	}
+
+	for typ, expected := range types {
+		parsed, err := NewType(typ)
+		if err != nil {
+			t.Errorf("cannot parse type %s: %v", typ, err)
+			continue
+		}
+
+		if parsed.String() != expected {
+			t.Errorf("got %v, wanted %v", parsed.String(), expected)
+		}
+	}
+}
+
+func TestNewTypeInvalid(t *testing.T) {
+	types := []string{
+		"", "uint7", "uint264", "int0", "bytes0", "bytes33", "fixed128",
+		"fixed128x81", "address[0]", "address[x]", "(uint256", "uint256)",
+		"(uint256))", "mapping", "[]",
+	}
+
+	for _, typ := range types {
+		if _, err := NewType(typ); err == nil {
+			t.Errorf("got %v, wanted an error for %s", err, typ)
+		}
+	}
+}
+
+func TestIsDynamic(t *testing.T) {
+	types := map[string]bool{
+		"uint256":             false,
+		"address[2]":          false,
+		"(uint256,bool)":      false,
+		"bytes":               true,
+		"string":              true,
+		"uint256[]":           true,
+		"string[2]":           true,
+		"(uint256,bytes)":     true,
+		"(uint256,bool)[3]":   false,
+		"(uint256,string)[3]": true,
+	}
+
+	for typ, expected := range types {
+		parsed, err := NewType(typ)
+		if err != nil {
+			t.Errorf("cannot parse type %s: %v", typ, err)
+			continue
+		}
+
+		if parsed.IsDynamic() != expected {
+			t.Errorf("%s: got %v, wanted %v", typ, parsed.IsDynamic(), expected)
+		}
+	}
+}
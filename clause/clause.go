@@ -2,6 +2,7 @@ package clause
 
 import (
 	"encoding/hex"
+	"math/big"
 
 	"github.com/mirzazhar/golang-transfer-clause/utils"
 )
@@ -22,8 +23,9 @@ func NewClause(t ClauseTransform, method string) (*Clause, error) {
 	}
 
 	clausebody := &ClauseBody{
-		to:    t.GetTokenAddress(),
+		rawTo: t.GetTokenAddress(),
 		value: "0",
+		unit:  Ether,
 		data:  hex.EncodeToString(data),
 	}
 	return clausebody.Build()
@@ -35,9 +37,21 @@ type Clause struct {
 	ClauseBody
 }
 
-// GetToAddress method returns the receiver address.
+// IsContractCreation method reports whether the clause deploys a contract,
+// i.e., it has no receiver address and its data holds the init code.
+func (cl *Clause) IsContractCreation() bool {
+	return cl.rawTo == ""
+}
+
+// GetToAddress method returns the receiver address as it was given.
 func (cl *Clause) GetToAddress() string {
-	return cl.to
+	return cl.rawTo
+}
+
+// GetTo method returns the parsed receiver address. It reports false for the
+// contract creation clause, which has no receiver.
+func (cl *Clause) GetTo() (utils.Address, bool) {
+	return cl.to, !cl.IsContractCreation()
 }
 
 // GetValue method returns the value.
@@ -45,25 +59,78 @@ func (cl *Clause) GetValue() string {
 	return cl.value
 }
 
+// GetUnit method returns the unit of the value.
+func (cl *Clause) GetUnit() Unit {
+	return cl.unit
+}
+
+// GetWei method returns the exact value in wei. It returns nil only if the
+// value has been replaced by an invalid one after Build.
+func (cl *Clause) GetWei() *big.Int {
+	wei, err := ToWei(cl.value, cl.unit)
+	if err != nil {
+		return nil
+	}
+	return wei
+}
+
+// FormatValue method renders the value in the given unit without any rounding.
+func (cl *Clause) FormatValue(unit Unit) string {
+	wei := cl.GetWei()
+	if wei == nil {
+		return ""
+	}
+	return FromWei(wei, unit)
+}
+
 // GetData method returns the arbitrary data.
 func (cl *Clause) GetData() string {
 	return cl.data
 }
 
+// GetDataBytes method returns the arbitrary data decoded from its hex encoding,
+// with or without prefix 0x. It returns an error if the data is not hex-encoded.
+func (cl *Clause) GetDataBytes() ([]byte, error) {
+	data := cl.data
+	if len(data) >= 2 && data[0] == '0' && (data[1] == 'x' || data[1] == 'X') {
+		data = data[2:]
+	}
+
+	decoded, err := hex.DecodeString(data)
+	if err != nil {
+		return nil, utils.ErrData
+	}
+	return decoded, nil
+}
+
 // ClauseBody holds the necessary transfer information to be used by
 // a transaction like a receiver address, amount, and arbitrary data.
 type ClauseBody struct {
-	to, value, data string
+	to          utils.Address
+	value, data string
+	unit        Unit
+	strict      bool
+
+	// rawTo holds the recipient address as given to the setters; Build
+	// parses it once into to.
+	rawTo string
 }
 
-// New creates and returns an empty instance of the ClauseBody.
+// New creates and returns an empty instance of the ClauseBody, whose value
+// is given in Ether unless another unit is added.
 func New() *ClauseBody {
-	return &ClauseBody{}
+	return &ClauseBody{unit: Ether}
 }
 
 // AddToAddress method adds the recipient address to its instance.
 func (cb *ClauseBody) AddToAddress(to string) *ClauseBody {
-	cb.to = to
+	cb.rawTo = to
+	return cb
+}
+
+// AddTo method adds the parsed recipient address to its instance.
+func (cb *ClauseBody) AddTo(to utils.Address) *ClauseBody {
+	cb.to, cb.rawTo = to, to.String()
 	return cb
 }
 
@@ -74,6 +141,13 @@ func (cb *ClauseBody) AddValue(value string) *ClauseBody {
 	return cb
 }
 
+// AddUnit method adds the unit of the value, e.g. Wei, Gwei or Ether. The
+// value is given in Ether unless a unit is added; Build rejects the zero Unit.
+func (cb *ClauseBody) AddUnit(unit Unit) *ClauseBody {
+	cb.unit = unit
+	return cb
+}
+
 // AddData method adds the arbitrary data to its object. Moreover,
 // this data will store within a transaction on the ledger.
 func (cb *ClauseBody) AddData(data string) *ClauseBody {
@@ -81,13 +155,74 @@ func (cb *ClauseBody) AddData(data string) *ClauseBody {
 	return cb
 }
 
+// AddStrictChecksum enables or disables the strict validation of the EIP-55
+// checksum. Once enabled, Build rejects the mixed-case recipient address
+// whose checksum is wrong.
+func (cb *ClauseBody) AddStrictChecksum(strict bool) *ClauseBody {
+	cb.strict = strict
+	return cb
+}
+
 // Build validates its underlying instance and then creates the
-// new instance of Clause.
+// new instance of Clause. It returns utils.ValidationErrors of all the failing
+// fields.
 func (cb *ClauseBody) Build() (*Clause, error) {
-	if !utils.IsValidAddress(cb.to) {
-		return nil, utils.ErrToAddress
+	var errs utils.ValidationErrors
+	to, err := utils.ParseAddress(cb.rawTo)
+	if err != nil {
+		errs = append(errs, utils.NewValidationError(utils.FieldTo, cb.rawTo, utils.ErrToAddress))
+	} else if cb.strict && !utils.IsValidChecksumAddress(cb.rawTo) {
+		errs = append(errs, utils.NewValidationError(utils.FieldTo, cb.rawTo, utils.ErrAddressChecksum))
+	}
+	if err := cb.validateValue(); err != nil {
+		errs = append(errs, err)
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	body := *cb
+	body.to = to
+	return &Clause{ClauseBody: body}, nil
+}
+
+// BuildContractCreation validates its underlying instance and then creates
+// the new instance of Clause that deploys a contract. Unlike Build, the
+// recipient address must be left empty and the data holds the init code of
+// the contract, i.e., its bytecode followed by the constructor arguments.
+func (cb *ClauseBody) BuildContractCreation() (*Clause, error) {
+	var errs utils.ValidationErrors
+	if cb.rawTo != "" {
+		errs = append(errs, utils.NewValidationError(utils.FieldTo, cb.rawTo, utils.ErrToAddress))
+	}
+	if err := cb.validateValue(); err != nil {
+		errs = append(errs, err)
+	}
+
+	cl := &Clause{ClauseBody: *cb}
+	if _, err := cl.GetDataBytes(); err != nil {
+		errs = append(errs, utils.NewValidationError(utils.FieldData, cb.data, err))
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return cl, nil
+}
+
+// validateValue validates the value in its unit, and returns the error of the
+// value field, or nil if it is valid.
+func (cb *ClauseBody) validateValue() *utils.ValidationError {
+	if cb.unit == (Unit{}) {
+		return utils.NewValidationError(utils.FieldValue, cb.value, utils.ErrUnit)
 	} else if !utils.IsValidValue(cb.value) {
-		return nil, utils.ErrValue
+		return utils.NewValidationError(utils.FieldValue, cb.value, utils.ErrValue)
+	}
+
+	wei, err := ToWei(cb.value, cb.unit)
+	if err != nil {
+		return utils.NewValidationError(utils.FieldValue, cb.value, err)
+	} else if _, err := utils.NewUint256(wei); err != nil {
+		return utils.NewValidationError(utils.FieldValue, cb.value, err)
 	}
-	return &Clause{ClauseBody: *cb}, nil
+	return nil
 }
@@ -1,8 +1,12 @@
 package clause
 
 import (
+	"errors"
 	"reflect"
+	"strings"
 	"testing"
+
+	"github.com/mirzazhar/golang-transfer-clause/utils"
 )
 
 var (
@@ -15,10 +19,13 @@ func TestCreateClause(t *testing.T) {
 		t.Errorf("cannot create clause: %v", err)
 	}
 
+	to, _ := utils.ParseAddress(address)
 	expectedclause := &Clause{
 		ClauseBody{
-			to:    address,
+			to:    to,
 			value: "2",
+			unit:  Ether,
+			rawTo: address,
 		},
 	}
 
@@ -26,3 +33,192 @@ func TestCreateClause(t *testing.T) {
 		t.Errorf("got %v, wanted %v", clause, expectedclause)
 	}
 }
+
+func TestCreateClauseStrictChecksum(t *testing.T) {
+	validaddresses := []string{address, "0x27D22890587cfaDA7fec247C5180D73dE6C670c4"}
+	for _, validaddress := range validaddresses {
+		_, err := New().AddToAddress(validaddress).AddValue("2").AddStrictChecksum(true).Build()
+		if err != nil {
+			t.Errorf("cannot create clause: %v", err)
+		}
+	}
+
+	typoaddress := "0x27D22890587cfaDA7fec247C5180D73dE6C670C4"
+	_, err := New().AddToAddress(typoaddress).AddValue("2").AddStrictChecksum(true).Build()
+	if !errors.Is(err, utils.ErrAddressChecksum) {
+		t.Errorf("got %v, wanted %v", err, utils.ErrAddressChecksum)
+	}
+
+	_, err = New().AddToAddress(typoaddress).AddValue("2").Build()
+	if err != nil {
+		t.Errorf("cannot create clause: %v", err)
+	}
+}
+
+func TestCreateClauseWei(t *testing.T) {
+	tests := []struct {
+		value    string
+		unit     Unit
+		expected string
+	}{
+		{"0.5", Ether, "500000000000000000"},
+		{"20", Gwei, "20000000000"},
+		{"7", Wei, "7"},
+	}
+
+	for _, test := range tests {
+		clause, err := New().AddToAddress(address).AddValue(test.value).AddUnit(test.unit).Build()
+		if err != nil {
+			t.Errorf("cannot create clause: %v", err)
+			continue
+		}
+
+		if clause.GetWei().String() != test.expected {
+			t.Errorf("got %v, wanted %v", clause.GetWei(), test.expected)
+		}
+	}
+
+	clause, err := New().AddToAddress(address).AddValue("1.5").AddUnit(Gwei).Build()
+	if err != nil {
+		t.Errorf("cannot create clause: %v", err)
+	}
+
+	if clause.FormatValue(Ether) != "0.0000000015" {
+		t.Errorf("got %v, wanted %v", clause.FormatValue(Ether), "0.0000000015")
+	}
+
+	_, err = New().AddToAddress(address).AddValue("0.5").AddUnit(Wei).Build()
+	if !errors.Is(err, utils.ErrDecimalPrecision) {
+		t.Errorf("got %v, wanted %v", err, utils.ErrDecimalPrecision)
+	}
+
+	// 2^256 wei does not fit in the uint256 value of the transaction.
+	_, err = New().AddToAddress(address).AddValue("115792089237316195423570985008687907853269984665640564039457584007913129639936").AddUnit(Wei).Build()
+	if !errors.Is(err, utils.ErrValueOutOfRange) {
+		t.Errorf("got %v, wanted %v", err, utils.ErrValueOutOfRange)
+	}
+	if _, err = New().AddToAddress(address).AddValue("1e200").Build(); err == nil {
+		t.Errorf("got %v, wanted an error", err)
+	}
+	_, err = New().AddToAddress(address).AddValue("1" + strings.Repeat("0", 200)).Build()
+	if !errors.Is(err, utils.ErrValueOutOfRange) {
+		t.Errorf("got %v, wanted %v", err, utils.ErrValueOutOfRange)
+	}
+
+	// the value is given in ether by default, but never in the zero unit.
+	clause, err = New().AddToAddress(address).AddValue("2").Build()
+	if err != nil || clause.GetUnit() != Ether {
+		t.Errorf("got %v, wanted %v: %v", clause, Ether, err)
+	}
+	_, err = New().AddToAddress(address).AddValue("2").AddUnit(Unit{}).Build()
+	if !errors.Is(err, utils.ErrUnit) {
+		t.Errorf("got %v, wanted %v", err, utils.ErrUnit)
+	}
+}
+
+func TestGetDataBytes(t *testing.T) {
+	for _, data := range []string{"cafe", "0xcafe", "0XCAFE"} {
+		clause, err := New().AddToAddress(address).AddValue("0").AddData(data).Build()
+		if err != nil {
+			t.Errorf("cannot create clause: %v", err)
+		}
+
+		databytes, err := clause.GetDataBytes()
+		if err != nil || !reflect.DeepEqual(databytes, []byte{0xca, 0xfe}) {
+			t.Errorf("got %v, wanted %v", databytes, []byte{0xca, 0xfe})
+		}
+	}
+
+	clause, err := New().AddToAddress(address).AddValue("0").AddData("coffee").Build()
+	if err != nil {
+		t.Errorf("cannot create clause: %v", err)
+	}
+
+	if _, err := clause.GetDataBytes(); err != utils.ErrData {
+		t.Errorf("got %v, wanted %v", err, utils.ErrData)
+	}
+}
+
+func TestCreateContractCreationClause(t *testing.T) {
+	clause, err := New().AddValue("0").AddData("0x6080604052").BuildContractCreation()
+	if err != nil {
+		t.Errorf("cannot create clause: %v", err)
+	}
+
+	if !clause.IsContractCreation() || clause.GetToAddress() != "" {
+		t.Errorf("got %v, wanted %v", clause.IsContractCreation(), true)
+	}
+
+	transfer, err := New().AddToAddress(address).AddValue("1").Build()
+	if err != nil {
+		t.Errorf("cannot create clause: %v", err)
+	}
+
+	if transfer.IsContractCreation() {
+		t.Errorf("got %v, wanted %v", transfer.IsContractCreation(), false)
+	}
+
+	tests := []struct {
+		body *ClauseBody
+		err  error
+	}{
+		{New().AddToAddress(address).AddValue("0").AddData("0x60"), utils.ErrToAddress},
+		{New().AddData("0x60"), utils.ErrValue},
+		{New().AddValue("0").AddData("0x6"), utils.ErrData},
+	}
+
+	for _, test := range tests {
+		_, err := test.body.BuildContractCreation()
+		if !errors.Is(err, test.err) {
+			t.Errorf("got %v, wanted %v", err, test.err)
+		}
+	}
+}
+
+func TestCreateClauseWithAddress(t *testing.T) {
+	to, _ := utils.ParseAddress(address)
+	clause, err := New().AddTo(to).AddValue("2").AddStrictChecksum(true).Build()
+	if err != nil {
+		t.Fatalf("cannot create clause: %v", err)
+	}
+
+	got, ok := clause.GetTo()
+	if !ok || got != to {
+		t.Errorf("got %v, wanted %v", got, to)
+	}
+
+	creation, _ := New().AddValue("0").AddData("6080").BuildContractCreation()
+	if _, ok := creation.GetTo(); ok {
+		t.Errorf("got %v, wanted %v", ok, false)
+	}
+
+	for _, to := range []string{"x", "0x", "0x27d228"} {
+		if _, err := New().AddToAddress(to).AddValue("2").Build(); err == nil {
+			t.Errorf("got %v, wanted %v for %s", err, utils.ErrToAddress, to)
+		}
+	}
+}
+
+func TestCreateClauseValidationErrors(t *testing.T) {
+	_, err := New().AddToAddress("0x27d228").AddValue("1.2.3").Build()
+	var errs utils.ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("got %v, wanted %T", err, errs)
+	}
+
+	wanted := []struct {
+		field string
+		err   error
+	}{
+		{utils.FieldTo, utils.ErrToAddress},
+		{utils.FieldValue, utils.ErrValue},
+	}
+	if len(errs) != len(wanted) {
+		t.Fatalf("got %v, wanted %v errors", errs, len(wanted))
+	}
+	for i, w := range wanted {
+		if errs[i].Field != w.field || !errors.Is(errs[i], w.err) {
+			t.Errorf("got %v, wanted %v %v", errs[i], w.field, w.err)
+		}
+	}
+}
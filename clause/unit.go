@@ -0,0 +1,60 @@
+package clause
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+// Unit represents a denomination of the native coin by its name and the number
+// of decimals relative to wei, the smallest denomination.
+type Unit struct {
+	Name     string
+	Decimals uint8
+}
+
+// Denominations of ether; the native coins of ethereum-based forks are mostly
+// denominated in the same way.
+var (
+	Wei    = Unit{Name: "wei", Decimals: 0}
+	Kwei   = Unit{Name: "kwei", Decimals: 3}
+	Mwei   = Unit{Name: "mwei", Decimals: 6}
+	Gwei   = Unit{Name: "gwei", Decimals: 9}
+	Szabo  = Unit{Name: "szabo", Decimals: 12}
+	Finney = Unit{Name: "finney", Decimals: 15}
+	Ether  = Unit{Name: "ether", Decimals: 18}
+)
+
+// units holds the denominations of ether by their names.
+var units = map[string]Unit{
+	Wei.Name: Wei, Kwei.Name: Kwei, Mwei.Name: Mwei, Gwei.Name: Gwei,
+	Szabo.Name: Szabo, Finney.Name: Finney, Ether.Name: Ether, "eth": Ether,
+}
+
+// CoinUnit creates the unit of a chain-specific native coin of 18 decimals,
+// e.g. VET, BNB or MATIC.
+func CoinUnit(name string) Unit {
+	return Unit{Name: name, Decimals: 18}
+}
+
+// ParseUnit returns the denomination of ether of the given case-insensitive name.
+func ParseUnit(name string) (Unit, error) {
+	unit, ok := units[strings.ToLower(name)]
+	if !ok {
+		return Unit{}, utils.ErrUnit
+	}
+	return unit, nil
+}
+
+// ToWei converts the given amount in the given unit, e.g. "0.5" ether, into
+// the exact amount of wei.
+func ToWei(amount string, unit Unit) (*big.Int, error) {
+	return utils.ParseUnits(amount, unit.Decimals)
+}
+
+// FromWei renders the given amount of wei in the given unit without any
+// rounding, e.g. 500000000000000000 wei as "0.5" ether.
+func FromWei(wei *big.Int, unit Unit) string {
+	return utils.FormatUnits(wei, unit.Decimals)
+}
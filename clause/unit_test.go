@@ -0,0 +1,68 @@
+package clause
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+func TestToWei(t *testing.T) {
+	tests := []struct {
+		amount   string
+		unit     Unit
+		expected string
+	}{
+		{"0.5", Ether, "500000000000000000"},
+		{"1.000000000000000001", Ether, "1000000000000000001"},
+		{"30", Gwei, "30000000000"},
+		{"0.5", Gwei, "500000000"},
+		{"21000", Wei, "21000"},
+		{"2.5", CoinUnit("VET"), "2500000000000000000"},
+	}
+
+	for _, test := range tests {
+		wei, err := ToWei(test.amount, test.unit)
+		if err != nil {
+			t.Errorf("cannot convert %s %s: %v", test.amount, test.unit.Name, err)
+			continue
+		}
+
+		if wei.String() != test.expected {
+			t.Errorf("got %v, wanted %v", wei, test.expected)
+		}
+	}
+
+	if _, err := ToWei("0.5", Wei); err != utils.ErrDecimalPrecision {
+		t.Errorf("got %v, wanted %v", err, utils.ErrDecimalPrecision)
+	}
+}
+
+func TestFromWei(t *testing.T) {
+	wei, _ := new(big.Int).SetString("1234567891234567891", 10)
+	tests := map[Unit]string{
+		Wei:   "1234567891234567891",
+		Gwei:  "1234567891.234567891",
+		Ether: "1.234567891234567891",
+	}
+
+	for unit, expected := range tests {
+		formatted := FromWei(wei, unit)
+		if formatted != expected {
+			t.Errorf("got %v, wanted %v", formatted, expected)
+		}
+	}
+}
+
+func TestParseUnit(t *testing.T) {
+	for name, expected := range map[string]Unit{"wei": Wei, "GWEI": Gwei, "Ether": Ether, "eth": Ether} {
+		unit, err := ParseUnit(name)
+		if err != nil || unit != expected {
+			t.Errorf("got %v, wanted %v", unit, expected)
+		}
+	}
+
+	if _, err := ParseUnit("bitcoin"); err != utils.ErrUnit {
+		t.Errorf("got %v, wanted %v", err, utils.ErrUnit)
+	}
+}
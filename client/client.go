@@ -0,0 +1,177 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+// Block tags of the JSON-RPC API to select the state a call is executed on.
+const (
+	Latest    = "latest"
+	Earliest  = "earliest"
+	Pending   = "pending"
+	Safe      = "safe"
+	Finalized = "finalized"
+)
+
+// Client sends the payloads of this library to an ethereum node over the
+// JSON-RPC API.
+type Client struct {
+	url        string
+	httpClient *http.Client
+	blockTag   string
+	id         uint64
+}
+
+// New creates and returns an instance of Client for the JSON-RPC endpoint of
+// the given URL. The calls are executed on the latest block unless another
+// block tag is added.
+func New(url string) *Client {
+	return &Client{url: url, httpClient: http.DefaultClient, blockTag: Latest}
+}
+
+// AddHTTPClient adds the HTTP client used to send the requests, e.g. to set
+// a timeout or a custom transport.
+func (c *Client) AddHTTPClient(httpClient *http.Client) *Client {
+	c.httpClient = httpClient
+	return c
+}
+
+// AddBlockTag adds the block tag the calls are executed on; one of Latest,
+// Earliest, Pending, Safe and Finalized or a block number of BlockNumber.
+func (c *Client) AddBlockTag(blockTag string) *Client {
+	c.blockTag = blockTag
+	return c
+}
+
+// BlockNumber returns the block tag of the given block number.
+func BlockNumber(number uint64) string {
+	return "0x" + strconv.FormatUint(number, 16)
+}
+
+// RPCError represents the error returned by the node.
+type RPCError struct {
+	Code    int             `json:"code"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+// Error returns the code and the message of the error.
+func (e *RPCError) Error() string {
+	return fmt.Sprintf("%v: %d %s", utils.ErrRPC, e.Code, e.Message)
+}
+
+// Unwrap returns ErrRPC, so errors.Is(err, utils.ErrRPC) holds.
+func (e *RPCError) Unwrap() error {
+	return utils.ErrRPC
+}
+
+// request represents a JSON-RPC request.
+type request struct {
+	JSONRPC string        `json:"jsonrpc"`
+	ID      uint64        `json:"id"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+}
+
+// response represents a JSON-RPC response.
+type response struct {
+	ID     uint64          `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  *RPCError       `json:"error"`
+}
+
+// callObject represents the transaction call object of eth_call.
+type callObject struct {
+	To   string `json:"to"`
+	Data string `json:"data"`
+}
+
+// Call executes eth_call of the given data on the contract of the given
+// address at the block tag of the client, and returns the return data.
+func (c *Client) Call(ctx context.Context, to string, data []byte) ([]byte, error) {
+	if !utils.IsValidAddress(to) {
+		return nil, utils.ErrContractAddress
+	} else if !isValidBlockTag(c.blockTag) {
+		return nil, utils.ErrBlockTag
+	}
+
+	var result string
+	call := callObject{To: to, Data: "0x" + hex.EncodeToString(data)}
+	if err := c.send(ctx, "eth_call", &result, call, c.blockTag); err != nil {
+		return nil, err
+	}
+
+	decoded, err := hex.DecodeString(strings.TrimPrefix(result, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("%w: result is not hex-encoded", utils.ErrReturnDataFormat)
+	}
+	return decoded, nil
+}
+
+// send sends the JSON-RPC request of the given method and parameters, and
+// unmarshals its result into the given value.
+func (c *Client) send(ctx context.Context, method string, result interface{}, params ...interface{}) error {
+	body, err := json.Marshal(request{
+		JSONRPC: "2.0",
+		ID:      atomic.AddUint64(&c.id, 1),
+		Method:  method,
+		Params:  params,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %v", utils.ErrRPC, err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("%w: %v", utils.ErrRPC, err)
+	} else if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: http status %s", utils.ErrRPC, resp.Status)
+	}
+
+	var res response
+	if err := json.Unmarshal(data, &res); err != nil {
+		return fmt.Errorf("%w: malformed response: %v", utils.ErrRPC, err)
+	} else if res.Error != nil {
+		return res.Error
+	} else if err := json.Unmarshal(res.Result, result); err != nil {
+		return fmt.Errorf("%w: malformed result: %v", utils.ErrRPC, err)
+	}
+	return nil
+}
+
+// isValidBlockTag validates the given block tag.
+func isValidBlockTag(blockTag string) bool {
+	switch blockTag {
+	case Latest, Earliest, Pending, Safe, Finalized:
+		return true
+	}
+
+	if !strings.HasPrefix(blockTag, "0x") || len(blockTag) == 2 {
+		return false
+	}
+	_, err := strconv.ParseUint(blockTag[2:], 16, 64)
+	return err == nil
+}
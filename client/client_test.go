@@ -0,0 +1,135 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+var contractaddress string = "0xdAC17F958D2ee523a2206206994597C13D831ec7"
+
+// stubRequest holds the request received by the stub node.
+type stubRequest struct {
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+	ID     uint64            `json:"id"`
+}
+
+// newStubNode starts a stub node that answers eth_call with the result of the
+// given calldata, keyed by its hex encoding with prefix 0x. The block tags of
+// the received requests are recorded in the given slice.
+func newStubNode(t *testing.T, results map[string]string, blockTags *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req stubRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Method != "eth_call" || len(req.Params) != 2 {
+			t.Errorf("got invalid request %v: %v", req, err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		var call callObject
+		var blockTag string
+		json.Unmarshal(req.Params[0], &call)
+		json.Unmarshal(req.Params[1], &blockTag)
+		if blockTags != nil {
+			*blockTags = append(*blockTags, blockTag)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		result, ok := results[call.Data]
+		if !ok || call.To != contractaddress {
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"jsonrpc": "2.0",
+				"id":      req.ID,
+				"error":   map[string]interface{}{"code": -32000, "message": "execution reverted"},
+			})
+			return
+		}
+
+		json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": result})
+	}))
+}
+
+func TestCall(t *testing.T) {
+	var blockTags []string
+	node := newStubNode(t, map[string]string{"0x313ce567": "0x" + strings.Repeat("0", 63) + "6"}, &blockTags)
+	defer node.Close()
+
+	client := New(node.URL)
+	data, err := client.Call(context.Background(), contractaddress, []byte{0x31, 0x3c, 0xe5, 0x67})
+	if err != nil {
+		t.Errorf("cannot call: %v", err)
+	}
+
+	if len(data) != 32 || data[31] != 6 {
+		t.Errorf("got %x, wanted %v", data, 6)
+	}
+
+	client.AddBlockTag(BlockNumber(18189758))
+	if _, err := client.Call(context.Background(), contractaddress, []byte{0x31, 0x3c, 0xe5, 0x67}); err != nil {
+		t.Errorf("cannot call: %v", err)
+	}
+
+	client.AddBlockTag(Finalized)
+	if _, err := client.Call(context.Background(), contractaddress, []byte{0x31, 0x3c, 0xe5, 0x67}); err != nil {
+		t.Errorf("cannot call: %v", err)
+	}
+
+	expected := []string{"latest", "0x1158dbe", "finalized"}
+	if strings.Join(blockTags, ",") != strings.Join(expected, ",") {
+		t.Errorf("got %v, wanted %v", blockTags, expected)
+	}
+}
+
+func TestCallErrors(t *testing.T) {
+	node := newStubNode(t, map[string]string{"0x06fdde03": "0xzz"}, nil)
+	defer node.Close()
+
+	client := New(node.URL)
+
+	// the node reverts the unknown calldata.
+	_, err := client.Call(context.Background(), contractaddress, []byte{0x01})
+	var rpcErr *RPCError
+	if !errors.As(err, &rpcErr) || rpcErr.Code != -32000 || !errors.Is(err, utils.ErrRPC) {
+		t.Errorf("got %v, wanted %v", err, utils.ErrRPC)
+	}
+
+	if _, err := client.Call(context.Background(), contractaddress, []byte{0x06, 0xfd, 0xde, 0x03}); !errors.Is(err, utils.ErrReturnDataFormat) {
+		t.Errorf("got %v, wanted %v", err, utils.ErrReturnDataFormat)
+	}
+
+	if _, err := client.Call(context.Background(), "0x01", nil); err != utils.ErrContractAddress {
+		t.Errorf("got %v, wanted %v", err, utils.ErrContractAddress)
+	}
+
+	for _, blockTag := range []string{"", "newest", "0x", "0xzz", "18189758"} {
+		client.AddBlockTag(blockTag)
+		if _, err := client.Call(context.Background(), contractaddress, nil); err != utils.ErrBlockTag {
+			t.Errorf("got %v, wanted %v", err, utils.ErrBlockTag)
+		}
+	}
+
+	// the node is not available.
+	node.Close()
+	client.AddBlockTag(Latest)
+	if _, err := client.Call(context.Background(), contractaddress, nil); !errors.Is(err, utils.ErrRPC) {
+		t.Errorf("got %v, wanted %v", err, utils.ErrRPC)
+	}
+}
+
+func TestCallHTTPStatus(t *testing.T) {
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer node.Close()
+
+	if _, err := New(node.URL).Call(context.Background(), contractaddress, nil); !errors.Is(err, utils.ErrRPC) {
+		t.Errorf("got %v, wanted %v", err, utils.ErrRPC)
+	}
+}
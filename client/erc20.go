@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/mirzazhar/golang-transfer-clause/erc20"
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+// TokenName calls the ERC-20-based name getter of the token and returns its name.
+func (c *Client) TokenName(ctx context.Context, erc *erc20.ERC20Clause) (string, error) {
+	data, err := c.Call(ctx, erc.GetTokenAddress(), erc.TokenName())
+	if err != nil {
+		return "", err
+	}
+	return erc20.DecodeName(data)
+}
+
+// TokenSymbol calls the ERC-20-based symbol getter of the token and returns its symbol.
+func (c *Client) TokenSymbol(ctx context.Context, erc *erc20.ERC20Clause) (string, error) {
+	data, err := c.Call(ctx, erc.GetTokenAddress(), erc.TokenSymbol())
+	if err != nil {
+		return "", err
+	}
+	return erc20.DecodeSymbol(data)
+}
+
+// TokenDecimals calls the ERC-20-based decimals getter of the token and returns
+// its decimals.
+func (c *Client) TokenDecimals(ctx context.Context, erc *erc20.ERC20Clause) (uint8, error) {
+	data, err := c.Call(ctx, erc.GetTokenAddress(), erc.TokenDecimals())
+	if err != nil {
+		return 0, err
+	}
+	return erc20.DecodeDecimals(data)
+}
+
+// TokenTotalSupply calls the ERC-20-based totalSupply getter of the token and
+// returns its total supply in base units.
+func (c *Client) TokenTotalSupply(ctx context.Context, erc *erc20.ERC20Clause) (*big.Int, error) {
+	data, err := c.Call(ctx, erc.GetTokenAddress(), erc.TokenTotalSupply())
+	if err != nil {
+		return nil, err
+	}
+	return erc20.DecodeTotalSupply(data)
+}
+
+// TokenBalance calls the ERC-20-based balanceOf getter of the token and returns
+// the balance of the receiver address of the clause in base units.
+func (c *Client) TokenBalance(ctx context.Context, erc *erc20.ERC20Clause) (*big.Int, error) {
+	payload, err := erc.TokenBalance()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.Call(ctx, erc.GetTokenAddress(), payload)
+	if err != nil {
+		return nil, err
+	}
+	return erc20.DecodeBalance(data)
+}
+
+// TokenAllowance calls the ERC-20-based allowance getter of the token with the
+// same arguments as ERC20Clause.TokenAllowance and returns the allowance in
+// base units.
+func (c *Client) TokenAllowance(ctx context.Context, erc *erc20.ERC20Clause, owner string) (*big.Int, error) {
+	payload, err := erc.TokenAllowance(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.Call(ctx, erc.GetTokenAddress(), payload)
+	if err != nil {
+		return nil, err
+	}
+	return erc20.DecodeAllowance(data)
+}
+
+// TokenLogs executes eth_getLogs of the given filter and returns the matching
+// logs, to be decoded by erc20.DecodeTransferLog or erc20.DecodeApprovalLog.
+// The block range is left to the node unless the filter holds it. It returns
+// ErrLogFilter if the filter is nil rather than querying every log.
+func (c *Client) TokenLogs(ctx context.Context, filter *erc20.LogFilter) ([]*erc20.Log, error) {
+	if filter == nil {
+		return nil, utils.ErrLogFilter
+	}
+
+	for _, blockTag := range []string{filter.GetFromBlock(), filter.GetToBlock()} {
+		if blockTag != "" && !isValidBlockTag(blockTag) {
+			return nil, utils.ErrBlockTag
+		}
+	}
+
+	var logs []*erc20.Log
+	if err := c.send(ctx, "eth_getLogs", &logs, filter); err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
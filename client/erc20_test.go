@@ -0,0 +1,130 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mirzazhar/golang-transfer-clause/erc20"
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+var (
+	address string = "0x27d22890587cfada7fec247c5180d73de6c670c4"
+	owner   string = "0xcf3aa1a77fa8c221f80bd15f4d7a36186eeb7df1"
+)
+
+// word returns the given hex-encoded value left padded to 32 bytes.
+func word(value string) string {
+	return strings.Repeat("0", 64-len(value)) + value
+}
+
+func TestTokenGetters(t *testing.T) {
+	erc, err := erc20.New().AddToAddress(address).AddValue("0").AddTokenAddress(contractaddress).Build()
+	if err != nil {
+		t.Errorf("cannot create erc20clause: %v", err)
+	}
+
+	balanceOf := "0x70a08231" + word(address[2:])
+	allowance := "0xdd62ed3e" + word(address[2:]) + word(owner[2:])
+	node := newStubNode(t, map[string]string{
+		"0x06fdde03": "0x" + word("20") + word("a") + "5465746865722055534400000000000000000000000000000000000000000000",
+		"0x95d89b41": "0x" + word("20") + word("4") + "5553445400000000000000000000000000000000000000000000000000000000",
+		"0x313ce567": "0x" + word("6"),
+		"0x18160ddd": "0x" + word("2d79883d2000"),
+		balanceOf:    "0x" + word("7270e00"),
+		allowance:    "0x" + strings.Repeat("f", 64),
+	}, nil)
+	defer node.Close()
+
+	ctx := context.Background()
+	client := New(node.URL)
+
+	name, err := client.TokenName(ctx, erc)
+	if err != nil || name != "Tether USD" {
+		t.Errorf("got %v, wanted %v: %v", name, "Tether USD", err)
+	}
+
+	symbol, err := client.TokenSymbol(ctx, erc)
+	if err != nil || symbol != "USDT" {
+		t.Errorf("got %v, wanted %v: %v", symbol, "USDT", err)
+	}
+
+	decimals, err := client.TokenDecimals(ctx, erc)
+	if err != nil || decimals != 6 {
+		t.Errorf("got %v, wanted %v: %v", decimals, 6, err)
+	}
+
+	totalSupply, err := client.TokenTotalSupply(ctx, erc)
+	if err != nil || totalSupply.String() != "50000000000000" {
+		t.Errorf("got %v, wanted %v: %v", totalSupply, "50000000000000", err)
+	}
+
+	balance, err := client.TokenBalance(ctx, erc)
+	if err != nil || balance.String() != "120000000" {
+		t.Errorf("got %v, wanted %v: %v", balance, "120000000", err)
+	}
+
+	allowed, err := client.TokenAllowance(ctx, erc, owner)
+	expected := "115792089237316195423570985008687907853269984665640564039457584007913129639935"
+	if err != nil || allowed.String() != expected {
+		t.Errorf("got %v, wanted %v: %v", allowed, expected, err)
+	}
+
+	// the stub node reverts the allowance of another owner.
+	if _, err := client.TokenAllowance(ctx, erc, address); err == nil {
+		t.Errorf("got %v, wanted an error", err)
+	}
+}
+
+func TestTokenLogs(t *testing.T) {
+	topic := "0x000000000000000000000000" + address[2:]
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req stubRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Method != "eth_getLogs" || len(req.Params) != 1 {
+			t.Errorf("got invalid request %v: %v", req, err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		wanted := `{"address":["` + contractaddress + `"],"topics":[["` + erc20.TransferTopic + `"],null,["` + topic + `"]],"fromBlock":"0x1158dbe"}`
+		if string(req.Params[0]) != wanted {
+			t.Errorf("got %v, wanted %v", string(req.Params[0]), wanted)
+		}
+
+		json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": []interface{}{
+			map[string]interface{}{
+				"address": contractaddress,
+				"topics":  []string{erc20.TransferTopic, "0x000000000000000000000000" + owner[2:], topic},
+				"data":    "0x" + word("7270e00"),
+			},
+		}})
+	}))
+	defer node.Close()
+
+	filter, err := erc20.NewTransferFilter().AddTokenAddress(contractaddress).AddToAddress(address).AddFromBlock(BlockNumber(18189758)).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	logs, err := New(node.URL).TokenLogs(context.Background(), filter)
+	if err != nil || len(logs) != 1 {
+		t.Fatalf("got %v, wanted %v: %v", len(logs), 1, err)
+	}
+
+	event, err := erc20.DecodeTransferLog(logs[0])
+	if err != nil || !strings.EqualFold(event.To, address) || event.Amount.String() != "120000000" {
+		t.Errorf("got %v, wanted the transfer to %v: %v", event, address, err)
+	}
+
+	filter, _ = erc20.NewTransferFilter().AddToBlock("0x").Build()
+	if _, err := New(node.URL).TokenLogs(context.Background(), filter); err != utils.ErrBlockTag {
+		t.Errorf("got %v, wanted %v", err, utils.ErrBlockTag)
+	}
+	if _, err := New(node.URL).TokenLogs(context.Background(), nil); err != utils.ErrLogFilter {
+		t.Errorf("got %v, wanted %v", err, utils.ErrLogFilter)
+	}
+}
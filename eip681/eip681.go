@@ -0,0 +1,257 @@
+package eip681
+
+import (
+	"fmt"
+	"math/big"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/mirzazhar/golang-transfer-clause/clause"
+	"github.com/mirzazhar/golang-transfer-clause/erc20"
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+// Scheme is the scheme of the EIP-681 uri.
+const Scheme = "ethereum"
+
+// Transfer is the function name of the ERC-20-based transfer request.
+const Transfer = "transfer"
+
+// Request represents the payment request parsed from an EIP-681 uri, i.e.,
+// ethereum:[pay-]<target>[@<chain id>][/<function>][?<parameters>].
+type Request struct {
+	// Target is the EIP-55 checksummed recipient of the native payment or the
+	// token address of the transfer request.
+	Target string
+
+	// ChainID is the chain the request is valid on, or nil if the uri leaves
+	// it to the current network of the wallet.
+	ChainID *big.Int
+
+	// Function is the function name, e.g. transfer, or empty for the native
+	// payment.
+	Function string
+
+	// Value, GasLimit and GasPrice hold the value in wei and the gas hints, or
+	// nil if the uri does not give them.
+	Value    *big.Int
+	GasLimit *big.Int
+	GasPrice *big.Int
+
+	// Parameters holds all the parameters of the uri by their keys, e.g. the
+	// recipient address and the uint256 amount of the transfer request.
+	Parameters map[string]string
+}
+
+// Parse parses the given EIP-681 uri. The numbers may be given in scientific
+// notation, e.g. 2.014e18, as long as they are non-negative integers. The target
+// must be an address; ENS names cannot be resolved offline.
+func Parse(uri string) (*Request, error) {
+	rest, ok := cutPrefix(uri, Scheme+":")
+	if !ok {
+		return nil, fmt.Errorf("%w: scheme must be %s", utils.ErrPaymentRequest, Scheme)
+	}
+	rest, _ = cutPrefix(rest, "pay-")
+
+	query := ""
+	if i := strings.Index(rest, "?"); i >= 0 {
+		rest, query = rest[:i], rest[i+1:]
+	}
+
+	request := &Request{Parameters: make(map[string]string)}
+	if i := strings.Index(rest, "/"); i >= 0 {
+		rest, request.Function = rest[:i], rest[i+1:]
+		if request.Function == "" {
+			return nil, fmt.Errorf("%w: function name is empty", utils.ErrPaymentRequest)
+		}
+	}
+
+	target := rest
+	if i := strings.Index(rest, "@"); i >= 0 {
+		chainID, err := parseNumber(rest[i+1:])
+		if err != nil || chainID.Sign() == 0 {
+			return nil, fmt.Errorf("%w: invalid chain id %q", utils.ErrPaymentRequest, rest[i+1:])
+		}
+		target, request.ChainID = rest[:i], chainID
+	}
+
+	address, err := utils.ToChecksumAddress(target)
+	if err != nil {
+		return nil, fmt.Errorf("%w: target %q must be an address", utils.ErrPaymentRequest, target)
+	}
+	request.Target = address
+
+	if err := request.parseParameters(query); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+// parseParameters parses the given query of the uri into the parameters and
+// the value and gas hints.
+func (r *Request) parseParameters(query string) error {
+	if query == "" {
+		return nil
+	}
+
+	// the parameters are percent-decoded without turning + into a space, so
+	// that exponents like 1e+18 survive.
+	for _, parameter := range strings.Split(query, "&") {
+		i := strings.Index(parameter, "=")
+		if i <= 0 {
+			return fmt.Errorf("%w: parameter %q must be key=value", utils.ErrPaymentRequest, parameter)
+		}
+
+		key, err := url.PathUnescape(parameter[:i])
+		if err != nil {
+			return fmt.Errorf("%w: %v", utils.ErrPaymentRequest, err)
+		}
+		value, err := url.PathUnescape(parameter[i+1:])
+		if err != nil {
+			return fmt.Errorf("%w: %v", utils.ErrPaymentRequest, err)
+		}
+
+		if _, ok := r.Parameters[key]; ok {
+			return fmt.Errorf("%w: parameter %s is given more than once", utils.ErrPaymentRequest, key)
+		}
+		r.Parameters[key] = value
+	}
+
+	// the hints are applied in this order, so that gasLimit takes precedence
+	// over its alias gas if both are given.
+	hints := []struct {
+		key  string
+		hint **big.Int
+	}{
+		{"value", &r.Value},
+		{"gas", &r.GasLimit},
+		{"gasLimit", &r.GasLimit},
+		{"gasPrice", &r.GasPrice},
+	}
+	for _, h := range hints {
+		key, hint := h.key, h.hint
+		value, ok := r.Parameters[key]
+		if !ok {
+			continue
+		}
+
+		number, err := parseNumber(value)
+		if err != nil {
+			return fmt.Errorf("%w: parameter %s: %v", utils.ErrPaymentRequest, key, err)
+		}
+		*hint = number
+	}
+	return nil
+}
+
+// CheckChainID validates the chain id of the request against the given chain
+// id. The request without any chain id is valid on every chain.
+func (r *Request) CheckChainID(chainID *big.Int) error {
+	if r.ChainID != nil && (chainID == nil || r.ChainID.Cmp(chainID) != 0) {
+		return fmt.Errorf("%w: request is for chain %s, got %v", utils.ErrChainIDMismatch, r.ChainID, chainID)
+	}
+	return nil
+}
+
+// IsERC20Transfer reports whether the request is the ERC-20-based transfer
+// request rather than the native payment.
+func (r *Request) IsERC20Transfer() bool {
+	return r.Function == Transfer
+}
+
+// Clause creates the instance of Clause of the native payment request on the
+// given chain, whose value is given in wei. It returns ErrChainIDMismatch if
+// the request is for another chain, and ErrUnsupportedFunction if it calls a
+// function.
+func (r *Request) Clause(chainID *big.Int) (*clause.Clause, error) {
+	if err := r.CheckChainID(chainID); err != nil {
+		return nil, err
+	} else if r.Function != "" {
+		return nil, fmt.Errorf("%w: %s is not a native payment", utils.ErrUnsupportedFunction, r.Function)
+	}
+
+	value := "0"
+	if r.Value != nil {
+		value = r.Value.String()
+	}
+	return clause.New().AddToAddress(r.Target).AddValue(value).AddUnit(clause.Wei).Build()
+}
+
+// ERC20Clause creates the instance of ERC20Clause of the transfer request on
+// the given chain, i.e., of the recipient address and the uint256 amount of
+// base units. It returns ErrChainIDMismatch if the request is for another
+// chain, and ErrUnsupportedFunction if it calls any other function.
+func (r *Request) ERC20Clause(chainID *big.Int) (*erc20.ERC20Clause, error) {
+	if err := r.CheckChainID(chainID); err != nil {
+		return nil, err
+	} else if !r.IsERC20Transfer() {
+		function := r.Function
+		if function == "" {
+			function = "native payment"
+		}
+		return nil, fmt.Errorf("%w: %s is not an erc-20 transfer", utils.ErrUnsupportedFunction, function)
+	} else if r.Value != nil && r.Value.Sign() != 0 {
+		return nil, fmt.Errorf("%w: transfer must not send a value", utils.ErrPaymentRequest)
+	}
+
+	address, ok := r.Parameters["address"]
+	if !ok {
+		return nil, fmt.Errorf("%w: transfer needs the address parameter", utils.ErrPaymentRequest)
+	}
+
+	to, err := utils.ToChecksumAddress(address)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parameter address %q must be an address", utils.ErrPaymentRequest, address)
+	}
+
+	amount, ok := r.Parameters["uint256"]
+	if !ok {
+		return nil, fmt.Errorf("%w: transfer needs the uint256 parameter", utils.ErrPaymentRequest)
+	}
+
+	value, err := parseNumber(amount)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parameter uint256: %v", utils.ErrPaymentRequest, err)
+	}
+	return erc20.New().AddToAddress(to).AddValue(value.String()).AddTokenAddress(r.Target).Build()
+}
+
+// parseNumber parses the given non-negative integer of EIP-681 in decimal or
+// scientific notation, e.g. 1, 1e6 or 2.014e18, which must fit in uint256.
+func parseNumber(number string) (*big.Int, error) {
+	mantissa, exponent, scientific := number, "", false
+	if i := strings.IndexAny(number, "eE"); i >= 0 {
+		mantissa, exponent, scientific = number[:i], strings.TrimPrefix(number[i+1:], "+"), true
+	}
+	mantissa = strings.TrimPrefix(mantissa, "+")
+
+	var decimals uint64
+	if scientific {
+		var err error
+		if decimals, err = strconv.ParseUint(exponent, 10, 8); err != nil || !utils.IsValidDecimalValue(exponent) {
+			return nil, fmt.Errorf("invalid exponent of %q", number)
+		}
+	}
+
+	if mantissa == "" || strings.HasPrefix(mantissa, ".") || strings.HasSuffix(mantissa, ".") {
+		return nil, fmt.Errorf("invalid number %q", number)
+	}
+
+	value, err := utils.ParseUnits(mantissa, uint8(decimals))
+	if err != nil {
+		return nil, fmt.Errorf("%q is not a non-negative integer", number)
+	} else if _, err := utils.NewUint256(value); err != nil {
+		return nil, fmt.Errorf("%q is above uint256", number)
+	}
+	return value, nil
+}
+
+// cutPrefix returns the given string without the given prefix, and reports
+// whether it has the prefix.
+func cutPrefix(s, prefix string) (string, bool) {
+	if !strings.HasPrefix(s, prefix) {
+		return s, false
+	}
+	return s[len(prefix):], true
+}
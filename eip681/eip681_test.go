@@ -0,0 +1,182 @@
+package eip681
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+var (
+	recipient string = "0x27d22890587cfada7fec247c5180d73de6c670c4"
+	usdt      string = "0xdAC17F958D2ee523a2206206994597C13D831ec7"
+)
+
+func TestParseNativePayment(t *testing.T) {
+	request, err := Parse("ethereum:0xfb6916095ca1df60bb79Ce92cE3Ea74c37c5d359?value=2.014e18")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if request.ChainID != nil || request.Function != "" || request.IsERC20Transfer() {
+		t.Errorf("got %v, wanted the native payment on any chain", request)
+	}
+
+	cl, err := request.Clause(big.NewInt(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, wanted := cl.GetToAddress(), "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359"; got != wanted {
+		t.Errorf("got %v, wanted %v", got, wanted)
+	}
+	if got, wanted := cl.GetWei().String(), "2014000000000000000"; got != wanted {
+		t.Errorf("got %v, wanted %v", got, wanted)
+	}
+
+	if _, err := request.ERC20Clause(nil); !errors.Is(err, utils.ErrUnsupportedFunction) {
+		t.Errorf("got %v, wanted %v", err, utils.ErrUnsupportedFunction)
+	}
+}
+
+func TestParseGasHints(t *testing.T) {
+	request, err := Parse("ethereum:pay-" + recipient + "@5?value=1e%2B16&gas=21000&gasPrice=2.5e9")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		got    *big.Int
+		wanted string
+	}{
+		{request.ChainID, "5"},
+		{request.Value, "10000000000000000"},
+		{request.GasLimit, "21000"},
+		{request.GasPrice, "2500000000"},
+	}
+	for _, test := range tests {
+		if test.got == nil || test.got.String() != test.wanted {
+			t.Errorf("got %v, wanted %v", test.got, test.wanted)
+		}
+	}
+}
+
+func TestParseGasLimitOverGas(t *testing.T) {
+	// gasLimit takes precedence over gas regardless of their order in the uri.
+	for _, query := range []string{"gas=21000&gasLimit=50000", "gasLimit=50000&gas=21000"} {
+		for i := 0; i < 10; i++ {
+			request, err := Parse("ethereum:" + recipient + "?" + query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, wanted := request.GasLimit.String(), "50000"; got != wanted {
+				t.Errorf("got %v, wanted %v", got, wanted)
+			}
+		}
+	}
+}
+
+func TestParseERC20Transfer(t *testing.T) {
+	request, err := Parse("ethereum:0xdac17f958d2ee523a2206206994597c13d831ec7@1/transfer?address=" + recipient + "&uint256=1e6")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := request.CheckChainID(big.NewInt(1)); err != nil {
+		t.Errorf("got %v, wanted %v", err, nil)
+	}
+	if err := request.CheckChainID(big.NewInt(56)); !errors.Is(err, utils.ErrChainIDMismatch) {
+		t.Errorf("got %v, wanted %v", err, utils.ErrChainIDMismatch)
+	}
+
+	erc20clause, err := request.ERC20Clause(big.NewInt(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	to, _ := utils.ToChecksumAddress(recipient)
+	if erc20clause.GetTokenAddress() != usdt || erc20clause.GetToAddress() != to || erc20clause.GetValue() != "1000000" {
+		t.Errorf("got %v, wanted the transfer of %v to %v", erc20clause, "1000000", to)
+	}
+
+	for _, chainID := range []*big.Int{big.NewInt(56), nil} {
+		if _, err := request.ERC20Clause(chainID); !errors.Is(err, utils.ErrChainIDMismatch) {
+			t.Errorf("got %v, wanted %v", err, utils.ErrChainIDMismatch)
+		}
+	}
+
+	if _, err := request.Clause(big.NewInt(1)); !errors.Is(err, utils.ErrUnsupportedFunction) {
+		t.Errorf("got %v, wanted %v", err, utils.ErrUnsupportedFunction)
+	}
+}
+
+func TestCheckChainIDWithoutChain(t *testing.T) {
+	request, _ := Parse("ethereum:" + recipient)
+	if err := request.CheckChainID(big.NewInt(137)); err != nil {
+		t.Errorf("got %v, wanted %v", err, nil)
+	}
+}
+
+func TestUnsupportedFunction(t *testing.T) {
+	request, err := Parse("ethereum:" + usdt + "/approve?address=" + recipient + "&uint256=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := request.ERC20Clause(nil); !errors.Is(err, utils.ErrUnsupportedFunction) {
+		t.Errorf("got %v, wanted %v", err, utils.ErrUnsupportedFunction)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	uris := []string{
+		"bitcoin:" + recipient,
+		"ethereum:vitalik.eth?value=1",
+		"ethereum:" + recipient + "@?value=1",
+		"ethereum:" + recipient + "@0",
+		"ethereum:" + recipient + "@x1",
+		"ethereum:" + usdt + "/",
+		"ethereum:" + recipient + "?value=1&value=2",
+		"ethereum:" + recipient + "?value",
+		"ethereum:" + recipient + "?value=-1",
+		"ethereum:" + recipient + "?value=1.5",
+		"ethereum:" + recipient + "?value=1.e3",
+		"ethereum:" + recipient + "?value=1e256",
+		"ethereum:" + recipient + "?value=1e200",
+		"ethereum:" + recipient + "?value=1e",
+		"ethereum:" + recipient + "?value=1E+",
+		"ethereum:" + recipient + "?value=e18",
+		"ethereum:" + recipient + "?value=",
+		"ethereum:" + recipient + "?value=%zz",
+		"ethereum:x",
+		"ethereum:",
+		"ethereum:0x",
+		"ethereum:pay-",
+	}
+
+	for _, uri := range uris {
+		if _, err := Parse(uri); !errors.Is(err, utils.ErrPaymentRequest) {
+			t.Errorf("got %v, wanted %v for %s", err, utils.ErrPaymentRequest, uri)
+		}
+	}
+}
+
+func TestERC20ClauseInvalid(t *testing.T) {
+	uris := []string{
+		"ethereum:" + usdt + "/transfer?uint256=1",
+		"ethereum:" + usdt + "/transfer?address=" + recipient,
+		"ethereum:" + usdt + "/transfer?address=" + recipient + "&uint256=0.5",
+		"ethereum:" + usdt + "/transfer?address=" + recipient + "&uint256=1&value=1",
+		"ethereum:" + usdt + "/transfer?address=0x27d228&uint256=1",
+		"ethereum:" + usdt + "/transfer?address=vitalik.eth&uint256=1",
+		"ethereum:" + usdt + "/transfer?address=1&uint256=1",
+		"ethereum:" + usdt + "/transfer?address=&uint256=1",
+		"ethereum:" + usdt + "/transfer?address=" + recipient + "&uint256=1e",
+	}
+
+	for _, uri := range uris {
+		request, err := Parse(uri)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := request.ERC20Clause(nil); !errors.Is(err, utils.ErrPaymentRequest) {
+			t.Errorf("got %v, wanted %v for %s", err, utils.ErrPaymentRequest, uri)
+		}
+	}
+}
@@ -0,0 +1,223 @@
+package eip681
+
+import (
+	"math/big"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mirzazhar/golang-transfer-clause/clause"
+	"github.com/mirzazhar/golang-transfer-clause/erc20"
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+// RequestBody holds the built clause to turn into a payment request along
+// with its optional chain id and gas hints.
+type RequestBody struct {
+	cl       *clause.Clause
+	erc      *erc20.ERC20Clause
+	chainID  *big.Int
+	gasLimit uint64
+	gasPrice *big.Int
+	readable bool
+}
+
+// FromClause creates and returns an instance of RequestBody of the native
+// payment of the given clause.
+func FromClause(cl *clause.Clause) *RequestBody {
+	return &RequestBody{cl: cl}
+}
+
+// FromERC20Clause creates and returns an instance of RequestBody of the
+// ERC-20-based transfer of the given clause.
+func FromERC20Clause(erc *erc20.ERC20Clause) *RequestBody {
+	return &RequestBody{erc: erc}
+}
+
+// AddChainID adds the chain id the request is valid on. It is optional.
+func (rb *RequestBody) AddChainID(chainID *big.Int) *RequestBody {
+	rb.chainID = chainID
+	return rb
+}
+
+// AddGasLimit adds the gas limit hint of the request. It is optional.
+func (rb *RequestBody) AddGasLimit(gasLimit uint64) *RequestBody {
+	rb.gasLimit = gasLimit
+	return rb
+}
+
+// AddGasPrice adds the gas price hint of the request in wei. It is optional.
+func (rb *RequestBody) AddGasPrice(gasPrice *big.Int) *RequestBody {
+	rb.gasPrice = gasPrice
+	return rb
+}
+
+// AddReadableAmounts enables or disables the human-readable amounts. Once
+// enabled, the amounts are given in scientific notation of their decimals, e.g.
+// 1.5e18 wei or 1.5e6 base units of a token of 6 decimals, using the decimals
+// registered in erc20.ER20TokenDecimals for the tokens.
+func (rb *RequestBody) AddReadableAmounts(readable bool) *RequestBody {
+	rb.readable = readable
+	return rb
+}
+
+// Build validates its underlying instance and then creates the new instance
+// of Request, which renders the EIP-681 uri. It returns utils.ValidationErrors
+// of all the failing fields.
+func (rb *RequestBody) Build() (*Request, error) {
+	var errs utils.ValidationErrors
+	if rb.chainID != nil && rb.chainID.Sign() <= 0 {
+		errs = append(errs, utils.NewValidationError(utils.FieldChainID, rb.chainID.String(), utils.ErrChainID))
+	}
+	if rb.gasPrice != nil && rb.gasPrice.Sign() < 0 {
+		errs = append(errs, utils.NewValidationError(utils.FieldGasPrice, rb.gasPrice.String(), utils.ErrGasPrice))
+	}
+
+	var request *Request
+	var requestErrs utils.ValidationErrors
+	switch {
+	case rb.cl != nil && rb.erc == nil:
+		request, requestErrs = rb.nativeRequest()
+	case rb.erc != nil && rb.cl == nil:
+		request, requestErrs = rb.transferRequest()
+	default:
+		requestErrs = utils.ValidationErrors{utils.NewValidationError(utils.FieldClause, "", utils.ErrClause)}
+	}
+	if errs = append(errs, requestErrs...); len(errs) > 0 {
+		return nil, errs
+	}
+
+	if rb.chainID != nil {
+		request.ChainID = new(big.Int).Set(rb.chainID)
+	}
+	if rb.gasLimit != 0 {
+		request.GasLimit = new(big.Int).SetUint64(rb.gasLimit)
+		request.Parameters["gasLimit"] = request.GasLimit.String()
+	}
+	if rb.gasPrice != nil {
+		request.GasPrice = new(big.Int).Set(rb.gasPrice)
+		request.Parameters["gasPrice"] = formatNumber(request.GasPrice, 9, rb.readable)
+	}
+	return request, nil
+}
+
+// nativeRequest creates the request of the native payment of the clause.
+func (rb *RequestBody) nativeRequest() (*Request, utils.ValidationErrors) {
+	to, ok := rb.cl.GetTo()
+	if !ok {
+		// the contract creation is not a payment.
+		return nil, utils.ValidationErrors{utils.NewValidationError(utils.FieldTo, "", utils.ErrUnsupportedFunction)}
+	}
+
+	var errs utils.ValidationErrors
+	if data, err := rb.cl.GetDataBytes(); err != nil {
+		errs = append(errs, utils.NewValidationError(utils.FieldData, rb.cl.GetData(), err))
+	} else if len(data) > 0 {
+		errs = append(errs, utils.NewValidationError(utils.FieldData, rb.cl.GetData(), utils.ErrUnsupportedFunction))
+	}
+
+	value := rb.cl.GetWei()
+	if value == nil {
+		errs = append(errs, utils.NewValidationError(utils.FieldValue, rb.cl.GetValue(), utils.ErrValue))
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return &Request{
+		Target:     to.String(),
+		Value:      value,
+		Parameters: map[string]string{"value": formatNumber(value, clause.Ether.Decimals, rb.readable)},
+	}, nil
+}
+
+// transferRequest creates the request of the ERC-20-based transfer of the
+// clause.
+func (rb *RequestBody) transferRequest() (*Request, utils.ValidationErrors) {
+	var errs utils.ValidationErrors
+	target := rb.erc.GetToken().String()
+	to, ok := rb.erc.GetTo()
+	if !ok {
+		errs = append(errs, utils.NewValidationError(utils.FieldTo, "", utils.ErrToAddress))
+	}
+
+	amount, err := utils.ParseUint256(rb.erc.GetValue())
+	if err != nil {
+		errs = append(errs, utils.NewValidationError(utils.FieldValue, rb.erc.GetValue(), err))
+	}
+
+	var decimals uint8
+	if rb.readable {
+		if decimals, ok = erc20.LookupTokenDecimals(target); !ok {
+			errs = append(errs, utils.NewValidationError(utils.FieldValue, rb.erc.GetValue(), utils.ErrUnknownDecimals))
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return &Request{
+		Target:   target,
+		Function: Transfer,
+		Parameters: map[string]string{
+			"address": to.String(),
+			"uint256": formatNumber(amount.Big(), decimals, rb.readable),
+		},
+	}, nil
+}
+
+// formatNumber formats the given integer in decimal, or in scientific notation
+// of the given decimals if readable, e.g. 1.5e18.
+func formatNumber(value *big.Int, decimals uint8, readable bool) string {
+	if !readable || decimals == 0 || value.Sign() == 0 {
+		return value.String()
+	}
+	return utils.FormatUnits(value, decimals) + "e" + strconv.Itoa(int(decimals))
+}
+
+// parameterOrder holds the position of the parameters rendered first; the
+// others follow in alphabetical order.
+var parameterOrder = map[string]int{"address": 1, "uint256": 2, "value": 3, "gas": 4, "gasLimit": 5, "gasPrice": 6}
+
+// String returns the EIP-681 uri of the request. The parameters are rendered
+// from Parameters, with the arguments of the function first.
+func (r *Request) String() string {
+	var uri strings.Builder
+	uri.WriteString(Scheme + ":" + r.Target)
+	if r.ChainID != nil {
+		uri.WriteString("@" + r.ChainID.String())
+	}
+	if r.Function != "" {
+		uri.WriteString("/" + r.Function)
+	}
+
+	keys := make([]string, 0, len(r.Parameters))
+	for key := range r.Parameters {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		x, y := parameterOrder[keys[i]], parameterOrder[keys[j]]
+		if x == 0 || y == 0 {
+			return y == 0 && (x != 0 || keys[i] < keys[j])
+		}
+		return x < y
+	})
+
+	for i, key := range keys {
+		if i == 0 {
+			uri.WriteString("?")
+		} else {
+			uri.WriteString("&")
+		}
+		uri.WriteString(escapeParameter(key) + "=" + escapeParameter(r.Parameters[key]))
+	}
+	return uri.String()
+}
+
+// escapeParameter percent-encodes the given key or value of a parameter,
+// including &, =, ?, # and +. The space is encoded as %20 rather than +, since
+// Parse keeps + as it is.
+func escapeParameter(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
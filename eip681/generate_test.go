@@ -0,0 +1,169 @@
+package eip681
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/mirzazhar/golang-transfer-clause/clause"
+	"github.com/mirzazhar/golang-transfer-clause/erc20"
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+func TestNativeRequest(t *testing.T) {
+	cl, err := clause.New().AddToAddress(recipient).AddValue("2.014").Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	request, err := FromClause(cl).AddChainID(big.NewInt(1)).AddGasLimit(21000).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	wanted := "ethereum:0x27D22890587cfaDA7fec247C5180D73dE6C670c4@1?value=2014000000000000000&gasLimit=21000"
+	if got := request.String(); got != wanted {
+		t.Errorf("got %v, wanted %v", got, wanted)
+	}
+
+	request, err = FromClause(cl).AddGasPrice(big.NewInt(2500000000)).AddReadableAmounts(true).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	wanted = "ethereum:0x27D22890587cfaDA7fec247C5180D73dE6C670c4?value=2.014e18&gasPrice=2.5e9"
+	if got := request.String(); got != wanted {
+		t.Errorf("got %v, wanted %v", got, wanted)
+	}
+
+	parsed, err := Parse(request.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsedclause, err := parsed.Clause(nil)
+	if err != nil || parsedclause.GetWei().Cmp(cl.GetWei()) != 0 {
+		t.Errorf("got %v, wanted %v: %v", parsedclause, cl.GetWei(), err)
+	}
+}
+
+func TestTransferRequest(t *testing.T) {
+	erc20.ER20TokenDecimals[strings.ToLower(usdt)] = 6
+	defer delete(erc20.ER20TokenDecimals, strings.ToLower(usdt))
+
+	erc20clause, err := erc20.New().AddToAddress(recipient).AddValue("1500000").AddTokenAddress(usdt).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	request, err := FromERC20Clause(erc20clause).AddChainID(big.NewInt(1)).AddReadableAmounts(true).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	wanted := "ethereum:0xdAC17F958D2ee523a2206206994597C13D831ec7@1/transfer?address=0x27D22890587cfaDA7fec247C5180D73dE6C670c4&uint256=1.5e6"
+	if got := request.String(); got != wanted {
+		t.Errorf("got %v, wanted %v", got, wanted)
+	}
+
+	parsed, err := Parse(request.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsedclause, err := parsed.ERC20Clause(big.NewInt(1))
+	if err != nil || parsedclause.GetValue() != "1500000" {
+		t.Errorf("got %v, wanted %v: %v", parsedclause, "1500000", err)
+	}
+
+	request, _ = FromERC20Clause(erc20clause).Build()
+	if got, wanted := request.Parameters["uint256"], "1500000"; got != wanted {
+		t.Errorf("got %v, wanted %v", got, wanted)
+	}
+}
+
+func TestTransferRequestUnknownDecimals(t *testing.T) {
+	erc20clause, _ := erc20.New().AddToAddress(recipient).AddValue("1").AddTokenAddress(usdt).Build()
+	if _, err := FromERC20Clause(erc20clause).AddReadableAmounts(true).Build(); !errors.Is(err, utils.ErrUnknownDecimals) {
+		t.Errorf("got %v, wanted %v", err, utils.ErrUnknownDecimals)
+	}
+}
+
+func TestRequestInvalid(t *testing.T) {
+	call, _ := clause.New().AddToAddress(usdt).AddValue("0").AddData("0x06fdde03").Build()
+	if _, err := FromClause(call).Build(); !errors.Is(err, utils.ErrUnsupportedFunction) {
+		t.Errorf("got %v, wanted %v", err, utils.ErrUnsupportedFunction)
+	}
+
+	creation, _ := clause.New().AddValue("0").AddData("6080").BuildContractCreation()
+	if _, err := FromClause(creation).Build(); !errors.Is(err, utils.ErrUnsupportedFunction) {
+		t.Errorf("got %v, wanted %v", err, utils.ErrUnsupportedFunction)
+	}
+
+	payment, _ := clause.New().AddToAddress(recipient).AddValue("1").Build()
+	if _, err := FromClause(payment).AddChainID(big.NewInt(0)).Build(); !errors.Is(err, utils.ErrChainID) {
+		t.Errorf("got %v, wanted %v", err, utils.ErrChainID)
+	}
+	if _, err := FromClause(payment).AddGasPrice(big.NewInt(-1)).Build(); !errors.Is(err, utils.ErrGasPrice) {
+		t.Errorf("got %v, wanted %v", err, utils.ErrGasPrice)
+	}
+	if _, err := FromClause(nil).Build(); !errors.Is(err, utils.ErrClause) {
+		t.Errorf("got %v, wanted %v", err, utils.ErrClause)
+	}
+}
+
+func TestRequestValidationErrors(t *testing.T) {
+	_, err := FromClause(nil).AddChainID(big.NewInt(-1)).AddGasPrice(big.NewInt(-1)).Build()
+	var errs utils.ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("got %v, wanted %T", err, errs)
+	}
+
+	wanted := []struct{ field, input string }{
+		{utils.FieldChainID, "-1"},
+		{utils.FieldGasPrice, "-1"},
+		{utils.FieldClause, ""},
+	}
+	if len(errs) != len(wanted) {
+		t.Fatalf("got %v, wanted %v errors", errs, len(wanted))
+	}
+	for i, w := range wanted {
+		if errs[i].Field != w.field || errs[i].Input != w.input {
+			t.Errorf("got %v %v, wanted %v %v", errs[i].Field, errs[i].Input, w.field, w.input)
+		}
+	}
+}
+
+func TestRequestStringOrder(t *testing.T) {
+	request := &Request{
+		Target:     recipient,
+		Function:   Transfer,
+		Parameters: map[string]string{"z": "1", "gasPrice": "2", "uint256": "3", "a": "4", "address": usdt},
+	}
+
+	wanted := "ethereum:" + recipient + "/transfer?address=" + usdt + "&uint256=3&gasPrice=2&a=4&z=1"
+	if got := request.String(); got != wanted {
+		t.Errorf("got %v, wanted %v", got, wanted)
+	}
+}
+
+func TestRequestStringEscaping(t *testing.T) {
+	request := &Request{
+		Target:     recipient,
+		Parameters: map[string]string{"value": "1", "label": "a&b=c?d#e+f g", "k&=": "v"},
+	}
+
+	wanted := "ethereum:" + recipient + "?value=1&k%26%3D=v&label=a%26b%3Dc%3Fd%23e%2Bf%20g"
+	if got := request.String(); got != wanted {
+		t.Errorf("got %v, wanted %v", got, wanted)
+	}
+
+	parsed, err := Parse(request.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for key, value := range request.Parameters {
+		if got := parsed.Parameters[key]; got != value {
+			t.Errorf("got %v, wanted %v", got, value)
+		}
+	}
+	if len(parsed.Parameters) != len(request.Parameters) {
+		t.Errorf("got %v, wanted %v", parsed.Parameters, request.Parameters)
+	}
+}
@@ -0,0 +1,65 @@
+package eip681
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+	"github.com/skip2/go-qrcode"
+)
+
+// qrCode encodes the uri of the request into a QR code of the medium error
+// recovery level, i.e., about 15% of the code may be damaged.
+func (r *Request) qrCode() (*qrcode.QRCode, error) {
+	return qrcode.New(r.String(), qrcode.Medium)
+}
+
+// PNG renders the uri of the request as the QR code PNG image of the given
+// width and height in pixels, including the quiet zone around it.
+func (r *Request) PNG(size int) ([]byte, error) {
+	if size <= 0 {
+		return nil, utils.ErrQRCodeSize
+	}
+
+	code, err := r.qrCode()
+	if err != nil {
+		return nil, err
+	}
+	return code.PNG(size)
+}
+
+// SVG renders the uri of the request as the QR code SVG image of the given
+// width and height in pixels, including the quiet zone around it. The modules
+// are drawn as a single path, so the image scales without any blur.
+func (r *Request) SVG(size int) ([]byte, error) {
+	if size <= 0 {
+		return nil, utils.ErrQRCodeSize
+	}
+
+	code, err := r.qrCode()
+	if err != nil {
+		return nil, err
+	}
+
+	bitmap := code.Bitmap()
+	var path strings.Builder
+	for y, row := range bitmap {
+		// the adjacent dark modules of a row are drawn as a single run.
+		for x := 0; x < len(row); x++ {
+			if !row[x] {
+				continue
+			}
+
+			start := x
+			for x < len(row) && row[x] {
+				x++
+			}
+			fmt.Fprintf(&path, "M%d %dh%dv1h-%dz", start, y, x-start, x-start)
+		}
+	}
+
+	svg := fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" shape-rendering="crispEdges">`+
+		`<rect width="100%%" height="100%%" fill="#ffffff"/><path fill="#000000" d="%s"/></svg>`,
+		size, size, len(bitmap), len(bitmap), path.String())
+	return []byte(svg), nil
+}
@@ -0,0 +1,91 @@
+package eip681
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+func createRequest(t *testing.T) *Request {
+	request, err := Parse("ethereum:" + usdt + "@1/transfer?address=" + recipient + "&uint256=1e6")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return request
+}
+
+func TestPNG(t *testing.T) {
+	data, err := createRequest(t).PNG(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	image, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bounds := image.Bounds(); bounds.Dx() != 256 || bounds.Dy() != 256 {
+		t.Errorf("got %v, wanted %v", bounds, "256x256")
+	}
+
+	if _, err := createRequest(t).PNG(0); err != utils.ErrQRCodeSize {
+		t.Errorf("got %v, wanted %v", err, utils.ErrQRCodeSize)
+	}
+}
+
+func TestSVG(t *testing.T) {
+	request := createRequest(t)
+	data, err := request.SVG(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var svg struct {
+		Width   string `xml:"width,attr"`
+		ViewBox string `xml:"viewBox,attr"`
+		Path    struct {
+			D string `xml:"d,attr"`
+		} `xml:"path"`
+	}
+	if err := xml.Unmarshal(data, &svg); err != nil {
+		t.Fatal(err)
+	}
+
+	code, _ := request.qrCode()
+	bitmap := code.Bitmap()
+	if wanted := fmt.Sprintf("0 0 %d %d", len(bitmap), len(bitmap)); svg.Width != "256" || svg.ViewBox != wanted {
+		t.Errorf("got %v %v, wanted %v %v", svg.Width, svg.ViewBox, 256, wanted)
+	}
+
+	// the runs of the path cover exactly the dark modules of the code.
+	drawn := make([][]bool, len(bitmap))
+	for i := range drawn {
+		drawn[i] = make([]bool, len(bitmap))
+	}
+	for _, run := range strings.Split(strings.TrimSuffix(svg.Path.D, "z"), "z") {
+		var x, y, width int
+		if _, err := fmt.Sscanf(run, "M%d %dh%dv1h", &x, &y, &width); err != nil {
+			t.Fatalf("got %v, wanted a run of modules: %v", run, err)
+		}
+		for i := x; i < x+width; i++ {
+			drawn[y][i] = true
+		}
+	}
+
+	for y := range bitmap {
+		for x := range bitmap[y] {
+			if drawn[y][x] != bitmap[y][x] {
+				t.Fatalf("got %v, wanted %v at module %d,%d", drawn[y][x], bitmap[y][x], x, y)
+			}
+		}
+	}
+
+	if _, err := request.SVG(-1); err != utils.ErrQRCodeSize {
+		t.Errorf("got %v, wanted %v", err, utils.ErrQRCodeSize)
+	}
+}
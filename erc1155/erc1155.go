@@ -0,0 +1,337 @@
+package erc1155
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+// ERC1155Body holds the necessary transfer information to be used by a
+// transaction that will interact with the ERC1155-based multi token standard.
+type ERC1155Body struct {
+	to, data         string
+	tokenAddress     string
+	tokenIDs, values []string
+	accounts         []string
+	approved         bool
+	extraData        []byte
+}
+
+// New creates and returns an empty instance of ERC1155Body.
+func New() *ERC1155Body {
+	return &ERC1155Body{}
+}
+
+// AddToAddress method adds the recipient address to its instance. The same
+// address is used as the operator for setApprovalForAll and isApprovedForAll.
+func (eb *ERC1155Body) AddToAddress(to string) *ERC1155Body {
+	eb.to = to
+	return eb
+}
+
+// AddTokenIDs method adds the token identifiers to its instance. The single
+// token methods use the first identifier only.
+func (eb *ERC1155Body) AddTokenIDs(tokenIDs ...string) *ERC1155Body {
+	eb.tokenIDs = append(eb.tokenIDs, tokenIDs...)
+	return eb
+}
+
+// AddValues method adds the "amounts to be transferred" to its instance; one
+// amount for each token identifier.
+func (eb *ERC1155Body) AddValues(values ...string) *ERC1155Body {
+	eb.values = append(eb.values, values...)
+	return eb
+}
+
+// AddAccounts method adds the account addresses to be used by balanceOfBatch;
+// one account for each token identifier.
+func (eb *ERC1155Body) AddAccounts(accounts ...string) *ERC1155Body {
+	eb.accounts = append(eb.accounts, accounts...)
+	return eb
+}
+
+// AddTokenAddress adds the contract address of the ERC1155-based standard
+// token.
+func (eb *ERC1155Body) AddTokenAddress(tokenAddr string) *ERC1155Body {
+	eb.tokenAddress = tokenAddr
+	return eb
+}
+
+// AddData adds an account address. Later on, this address will use as a
+// parameter for ERC1155-based token methods: safeTransferFrom and
+// safeBatchTransferFrom (from) and isApprovedForAll (account).
+func (eb *ERC1155Body) AddData(data string) *ERC1155Body {
+	eb.data = data
+	return eb
+}
+
+// AddApproval adds the approval flag to be used by setApprovalForAll.
+func (eb *ERC1155Body) AddApproval(approved bool) *ERC1155Body {
+	eb.approved = approved
+	return eb
+}
+
+// AddExtraData adds the arbitrary bytes passed along by safeTransferFrom and
+// safeBatchTransferFrom to the receiving contract.
+func (eb *ERC1155Body) AddExtraData(extraData []byte) *ERC1155Body {
+	eb.extraData = extraData
+	return eb
+}
+
+// Build validates its underlying instance and then creates the
+// new instance of ERC1155Clause. It returns utils.ValidationErrors of all the
+// failing fields.
+func (b *ERC1155Body) Build() (*ERC1155Clause, error) {
+	var errs utils.ValidationErrors
+	if !utils.IsValidAddress(b.tokenAddress) {
+		errs = append(errs, utils.NewValidationError(utils.FieldTokenAddress, b.tokenAddress, utils.ErrTokenAddress))
+	} else if utils.EqualAddresses(b.tokenAddress, b.to) {
+		errs = append(errs, utils.NewValidationError(utils.FieldTo, b.to, utils.ErrSameEOAContractAddr))
+	}
+
+	if len(b.tokenIDs) == 0 {
+		errs = append(errs, utils.NewValidationError(utils.FieldTokenID, "", utils.ErrTokenID))
+	}
+	for _, tokenID := range b.tokenIDs {
+		if !utils.IsValidDecimalValue(tokenID) {
+			errs = append(errs, utils.NewValidationError(utils.FieldTokenID, tokenID, utils.ErrTokenID))
+		} else if _, err := utils.ParseUint256(tokenID); err != nil {
+			errs = append(errs, utils.NewValidationError(utils.FieldTokenID, tokenID, err))
+		}
+	}
+
+	if len(b.values) != 0 && len(b.values) != len(b.tokenIDs) {
+		errs = append(errs, utils.NewValidationError(utils.FieldValue, strings.Join(b.values, ","), utils.ErrArrayLength))
+	}
+	for _, value := range b.values {
+		if _, err := utils.ParseUint256(value); err != nil {
+			errs = append(errs, utils.NewValidationError(utils.FieldValue, value, err))
+		}
+	}
+
+	if len(b.accounts) != 0 && len(b.accounts) != len(b.tokenIDs) {
+		errs = append(errs, utils.NewValidationError(utils.FieldAccounts, strings.Join(b.accounts, ","), utils.ErrArrayLength))
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return &ERC1155Clause{ERC1155Body: *b}, nil
+}
+
+// ERC1155Clause represents the transfer information for the ERC-1155 standard used by
+// a transaction within an ethereum or ethereum-based fork.
+type ERC1155Clause struct {
+	ERC1155Body
+}
+
+// GetTokenAddress returns the contract address of the ERC-1155 standard token.
+func (erc *ERC1155Clause) GetTokenAddress() string {
+	return erc.tokenAddress
+}
+
+// GetToAddress returns the receiver address for the ERC-1155 standard token.
+func (erc *ERC1155Clause) GetToAddress() string {
+	return erc.to
+}
+
+// TokenBalance returns the payload of the token balance of the recipient address for
+// the ERC-1155-based getter.
+func (erc *ERC1155Clause) TokenBalance() ([]byte, error) {
+	return erc.payload(balance, []string{erc.to}, erc.tokenIDs[:1])
+}
+
+// TokenBalanceOfBatch returns the payload of the token balances of the given accounts
+// for the ERC-1155-based getter.
+func (erc *ERC1155Clause) TokenBalanceOfBatch() ([]byte, error) {
+	if len(erc.accounts) != len(erc.tokenIDs) {
+		return nil, utils.ErrArrayLength
+	}
+
+	accounts, err := addressArray(erc.accounts)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenIDs, err := uintArray(erc.tokenIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	head, err := erc.payload(balanceOfBatch, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return dynamicPayload(head, accounts, tokenIDs), nil
+}
+
+// TokenURI returns the payload of the token metadata URI for the ERC-1155-based getter.
+func (erc *ERC1155Clause) TokenURI() ([]byte, error) {
+	return erc.payload(uri, nil, erc.tokenIDs[:1])
+}
+
+// TokenSetApprovalForAll returns the payload to enable or disable the approval of an
+// operator to manage all tokens of the caller for the ERC-1155-based method.
+func (erc *ERC1155Clause) TokenSetApprovalForAll(approved bool) ([]byte, error) {
+	payload, err := erc.payload(setApprovalForAll, []string{erc.to}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	flag := make([]byte, 32)
+	if approved {
+		flag[31] = 1
+	}
+	return append(payload, flag...), nil
+}
+
+// TokenIsApprovedForAll returns the payload to find whether an operator is allowed to
+// manage all tokens of the account for the ERC-1155-based getter.
+func (erc *ERC1155Clause) TokenIsApprovedForAll(account string) ([]byte, error) {
+	return erc.payload(isApprovedForAll, []string{account, erc.to}, nil)
+}
+
+// TokenSafeTransferFrom returns the payload of the safe transfer of the first token
+// identifier and value for the ERC-1155-based method.
+func (erc *ERC1155Clause) TokenSafeTransferFrom(from string) ([]byte, error) {
+	if len(erc.values) == 0 {
+		return nil, utils.ErrValue
+	}
+
+	head, err := erc.payload(safeTransferFrom, []string{from, erc.to},
+		[]string{erc.tokenIDs[0], erc.values[0]})
+	if err != nil {
+		return nil, err
+	}
+	return dynamicPayload(head, bytesData(erc.extraData)), nil
+}
+
+// TokenSafeBatchTransferFrom returns the payload of the safe transfer of all token
+// identifiers and values for the ERC-1155-based method.
+func (erc *ERC1155Clause) TokenSafeBatchTransferFrom(from string) ([]byte, error) {
+	if len(erc.values) != len(erc.tokenIDs) {
+		return nil, utils.ErrArrayLength
+	}
+
+	head, err := erc.payload(safeBatchTransferFrom, []string{from, erc.to}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenIDs, err := uintArray(erc.tokenIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := uintArray(erc.values)
+	if err != nil {
+		return nil, err
+	}
+	return dynamicPayload(head, tokenIDs, values, bytesData(erc.extraData)), nil
+}
+
+// payload creates the data array of the given method using the given addresses
+// followed by the given unsigned integers.
+func (erc *ERC1155Clause) payload(method string, addresses, integers []string) ([]byte, error) {
+	methodID := erc1155methodIDs[method]
+
+	var data []byte
+	data = append(data, methodID[:]...)
+	for _, addr := range addresses {
+		address, err := utils.AddresstoBytes(addr)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, utils.LeftPadBytes(address, 32)...)
+	}
+
+	for _, integer := range integers {
+		word, err := uintWord(integer)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, word...)
+	}
+	return data, nil
+}
+
+// dynamicPayload appends the offsets of the given dynamic values to the head and
+// then the values themselves.
+func dynamicPayload(head []byte, dynamics ...[]byte) []byte {
+	// the offsets are counted from the start of the arguments, i.e., without
+	// the method ID of 4 bytes.
+	offset := len(head) - 4 + len(dynamics)*32
+	var tail []byte
+	for _, dynamic := range dynamics {
+		head = append(head, utils.LeftPadBytes(big.NewInt(int64(offset)).Bytes(), 32)...)
+		tail = append(tail, dynamic...)
+		offset += len(dynamic)
+	}
+	return append(head, tail...)
+}
+
+// uintWord converts the given integer string into a word of 32 bytes.
+func uintWord(integer string) ([]byte, error) {
+	value, err := utils.ParseUint256(integer)
+	if err != nil {
+		return nil, err
+	}
+	return value.Word(), nil
+}
+
+// uintArray encodes the given integer strings as the dynamic uint256[] array.
+func uintArray(integers []string) ([]byte, error) {
+	data := utils.LeftPadBytes(big.NewInt(int64(len(integers))).Bytes(), 32)
+	for _, integer := range integers {
+		word, err := uintWord(integer)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, word...)
+	}
+	return data, nil
+}
+
+// addressArray encodes the given account addresses as the dynamic address[] array.
+func addressArray(addresses []string) ([]byte, error) {
+	data := utils.LeftPadBytes(big.NewInt(int64(len(addresses))).Bytes(), 32)
+	for _, addr := range addresses {
+		address, err := utils.AddresstoBytes(addr)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, utils.LeftPadBytes(address, 32)...)
+	}
+	return data, nil
+}
+
+// bytesData encodes the given bytes as the dynamic bytes value.
+func bytesData(data []byte) []byte {
+	length := utils.LeftPadBytes(big.NewInt(int64(len(data))).Bytes(), 32)
+	paddedLength := (len(data) + 31) / 32 * 32
+	return append(length, utils.RightPadBytes(data, paddedLength)...)
+}
+
+// GetERCPayloadData returns the payload of the given method in a byte array. Moreover, purposely,
+// it can be called from outside this package or through the interface.
+func (erc *ERC1155Clause) GetERCPayloadData(method string) ([]byte, error) {
+	switch method {
+	case "balanceOf":
+		return erc.TokenBalance()
+	case "balanceOfBatch":
+		return erc.TokenBalanceOfBatch()
+	case "uri":
+		return erc.TokenURI()
+	case "setApprovalForAll":
+		return erc.TokenSetApprovalForAll(erc.approved)
+	case "isApprovedForAll":
+		return erc.TokenIsApprovedForAll(erc.data)
+	case "safeTransferFrom":
+		return erc.TokenSafeTransferFrom(erc.data)
+	case "safeBatchTransferFrom":
+		return erc.TokenSafeBatchTransferFrom(erc.data)
+	default:
+		return nil, utils.NewValidationError(utils.FieldMethod, method, utils.ErrMethod)
+	}
+}
@@ -0,0 +1,170 @@
+package erc1155
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/mirzazhar/golang-transfer-clause/clause"
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+var (
+	address         string = "0x27d22890587cfada7fec247c5180d73de6c670c4"
+	contractaddress string = "0xf6fe970533fe5C63d196139B14522Eb2956f8621"
+	fromaddress     string = "0x0bf4A8E0D09C3B16Bb6B90362Bc4218589b0a567"
+)
+
+const (
+	paddedaddress     = "00000000000000000000000027d22890587cfada7fec247c5180d73de6c670c4"
+	paddedfromaddress = "0000000000000000000000000bf4a8e0d09c3b16bb6b90362bc4218589b0a567"
+)
+
+// word returns the hex of the given integer padded to 32 bytes.
+func word(n int) string {
+	return fmt.Sprintf("%064x", n)
+}
+
+func createERC1155Clause() (*ERC1155Clause, error) {
+	erc1155clause, err := New().
+		AddToAddress(address).
+		AddTokenIDs("1", "2").
+		AddValues("10", "20").
+		AddAccounts(address, fromaddress).
+		AddTokenAddress(contractaddress).
+		AddData(fromaddress).
+		Build()
+	return erc1155clause, err
+}
+
+func TestCreateClause(t *testing.T) {
+	erc1155clause, err := createERC1155Clause()
+	if err != nil {
+		t.Errorf("cannot create erc1155clause: %v", err)
+	}
+
+	expectederc1155clause := &ERC1155Clause{
+		ERC1155Body{
+			to:           address,
+			data:         fromaddress,
+			tokenAddress: contractaddress,
+			tokenIDs:     []string{"1", "2"},
+			values:       []string{"10", "20"},
+			accounts:     []string{address, fromaddress},
+		},
+	}
+
+	if !reflect.DeepEqual(erc1155clause, expectederc1155clause) {
+		t.Errorf("got %v, wanted %v", erc1155clause, expectederc1155clause)
+	}
+}
+
+func TestCreateClauseInvalid(t *testing.T) {
+	_, err := New().AddToAddress(address).AddTokenIDs("1", "2").AddValues("10").
+		AddTokenAddress(contractaddress).Build()
+	if err == nil {
+		t.Errorf("got %v, wanted an error for arrays of different lengths", err)
+	}
+
+	_, err = New().AddToAddress(address).AddTokenAddress(contractaddress).Build()
+	if err == nil {
+		t.Errorf("got %v, wanted an error for missing token ids", err)
+	}
+
+	_, err = New().AddToAddress(address).AddTokenIDs("1", "x").AddValues("10").AddAccounts(address).
+		AddTokenAddress(contractaddress).Build()
+	var errs utils.ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("got %v, wanted %T", err, errs)
+	}
+
+	wanted := []struct{ field, code string }{
+		{utils.FieldTokenID, utils.CodeInvalidValue},
+		{utils.FieldValue, utils.CodeArrayLength},
+		{utils.FieldAccounts, utils.CodeArrayLength},
+	}
+	if len(errs) != len(wanted) {
+		t.Fatalf("got %v, wanted %v errors", errs, len(wanted))
+	}
+	for i, w := range wanted {
+		if errs[i].Field != w.field || errs[i].Code != w.code {
+			t.Errorf("got %v %v, wanted %v %v", errs[i].Field, errs[i].Code, w.field, w.code)
+		}
+	}
+}
+
+func TestPayloads(t *testing.T) {
+	erc1155clause, err := createERC1155Clause()
+	if err != nil {
+		t.Errorf("cannot create erc1155clause: %v", err)
+	}
+
+	expectedpayloads := map[string]string{
+		"balanceOf":         "00fdd58e" + paddedaddress + word(1),
+		"uri":               "0e89341c" + word(1),
+		"isApprovedForAll":  "e985e9c5" + paddedfromaddress + paddedaddress,
+		"setApprovalForAll": "a22cb465" + paddedaddress + word(0),
+		"safeTransferFrom": "f242432a" + paddedfromaddress + paddedaddress +
+			word(1) + word(10) + word(0xa0) + word(0),
+		"safeBatchTransferFrom": "2eb2c2d6" + paddedfromaddress + paddedaddress +
+			word(0xa0) + word(0x100) + word(0x160) +
+			word(2) + word(1) + word(2) +
+			word(2) + word(10) + word(20) +
+			word(0),
+		"balanceOfBatch": "4e1273f4" + word(0x40) + word(0xa0) +
+			word(2) + paddedaddress + paddedfromaddress +
+			word(2) + word(1) + word(2),
+	}
+
+	for method, expected := range expectedpayloads {
+		payloaddata, err := erc1155clause.GetERCPayloadData(method)
+		if err != nil {
+			t.Errorf("cannot create payload data for %s: %v", method, err)
+		}
+
+		hexvaluepayload := hex.EncodeToString(payloaddata)
+		if hexvaluepayload != expected {
+			t.Errorf("%s: got %v, wanted %v", method, hexvaluepayload, expected)
+		}
+	}
+}
+
+func TestSafeTransferFromWithData(t *testing.T) {
+	erc1155clause, err := createERC1155Clause()
+	if err != nil {
+		t.Errorf("cannot create erc1155clause: %v", err)
+	}
+
+	erc1155clause.AddExtraData([]byte{0xca, 0xfe})
+	payloaddata, err := erc1155clause.TokenSafeTransferFrom(fromaddress)
+	if err != nil {
+		t.Errorf("cannot create payload data for safeTransferFrom: %v", err)
+	}
+
+	hexvaluepayload := hex.EncodeToString(payloaddata)
+	expected := "f242432a" + paddedfromaddress + paddedaddress +
+		word(1) + word(10) + word(0xa0) + word(2) +
+		"cafe000000000000000000000000000000000000000000000000000000000000"
+	if hexvaluepayload != expected {
+		t.Errorf("got %v, wanted %v", hexvaluepayload, expected)
+	}
+}
+
+func TestNewClause(t *testing.T) {
+	erc1155clause, err := createERC1155Clause()
+	if err != nil {
+		t.Errorf("cannot create erc1155clause: %v", err)
+	}
+
+	cl, err := clause.NewClause(erc1155clause, "uri")
+	if err != nil {
+		t.Errorf("cannot create clause: %v", err)
+	}
+
+	expected := "0e89341c" + word(1)
+	if cl.GetToAddress() != contractaddress || cl.GetData() != expected {
+		t.Errorf("got %v, wanted %v", cl.GetData(), expected)
+	}
+}
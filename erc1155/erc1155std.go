@@ -0,0 +1,36 @@
+package erc1155
+
+import (
+	"github.com/mirzazhar/golang-transfer-clause/selector"
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+// ERC1155-based multi token standard; getters and functions.
+var (
+	balance               string = "balanceOf(address,uint256)"
+	balanceOfBatch        string = "balanceOfBatch(address[],uint256[])"
+	uri                   string = "uri(uint256)"
+	setApprovalForAll     string = "setApprovalForAll(address,bool)"
+	isApprovedForAll      string = "isApprovedForAll(address,address)"
+	safeTransferFrom      string = "safeTransferFrom(address,address,uint256,uint256,bytes)"
+	safeBatchTransferFrom string = "safeBatchTransferFrom(address,address,uint256[],uint256[],bytes)"
+)
+
+// erc1155methodIDs holds the method ID of the ERC1155-based multi token standard;
+// its signatures are registered in selector.Default as well.
+var erc1155methodIDs = make(map[string][4]byte)
+
+func init() {
+	erc1155standard := []string{balance, balanceOfBatch, uri, setApprovalForAll,
+		isApprovedForAll, safeTransferFrom, safeBatchTransferFrom}
+
+	for _, method := range erc1155standard {
+		erc1155methodIDs[method] = methodID(method)
+		selector.Default.Register(method)
+	}
+}
+
+// methodID calculates and returns the method ID of 4 bytes.
+func methodID(method string) [4]byte {
+	return utils.MethodID(method)
+}
@@ -0,0 +1,26 @@
+package erc1155
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestMethodID(t *testing.T) {
+	methodIDs := make(map[string]string)
+	methodIDs[balance] = "00fdd58e"
+	methodIDs[balanceOfBatch] = "4e1273f4"
+	methodIDs[uri] = "0e89341c"
+	methodIDs[setApprovalForAll] = "a22cb465"
+	methodIDs[isApprovedForAll] = "e985e9c5"
+	methodIDs[safeTransferFrom] = "f242432a"
+	methodIDs[safeBatchTransferFrom] = "2eb2c2d6"
+
+	for method, expectedmethodID := range methodIDs {
+		id := methodID(method)
+		methodId := hex.EncodeToString(id[:])
+
+		if methodId != expectedmethodID {
+			t.Errorf("got %v, wanted %v", methodId, expectedmethodID)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package erc20
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+// Calldata holds the method and the arguments decoded from the calldata of the
+// ERC-20-based token standard. The arguments are named after their position in
+// the standard methods, e.g. transferFrom(from, to, value) and
+// allowance(owner, spender). The addresses are EIP-55 checksummed, and the
+// arguments the method does not take are left empty.
+type Calldata struct {
+	// Method is the method name as accepted by GetERCPayloadData, e.g. transfer.
+	Method string
+
+	// Signature is the canonical signature of the method.
+	Signature string
+
+	// To is the recipient of transfer and transferFrom.
+	To string
+
+	// From is the account transferFrom takes the tokens from.
+	From string
+
+	// Owner is the account of balanceOf and the owner of allowance.
+	Owner string
+
+	// Spender is the spender of approve and allowance.
+	Spender string
+
+	// Amount is the amount of base units of transfer, approve and transferFrom.
+	Amount *big.Int
+}
+
+// DecodeCalldata decodes the given calldata of the ERC-20-based token standard.
+// It is the inverse of GetERCPayloadData. Note that TokenTransferFrom and
+// TokenAllowance encode the address added by AddToAddress as their first
+// argument, so it is decoded as From and Owner, and their given address as To
+// and Spender.
+func DecodeCalldata(data []byte) (*Calldata, error) {
+	if len(data) < 4 {
+		return nil, fmt.Errorf("%w: got %d bytes", utils.ErrCalldata, len(data))
+	}
+
+	signature, ok := lookupSelector(data[:4])
+	if !ok {
+		return nil, fmt.Errorf("%w: 0x%x", utils.ErrSelector, data[:4])
+	}
+
+	calldata := &Calldata{Method: signature[:strings.Index(signature, "(")], Signature: signature}
+	args := data[4:]
+
+	// the addresses of the arguments in their order, followed by the amount
+	// if the method takes it.
+	var addresses []*string
+	var amount bool
+	switch signature {
+	case balance:
+		addresses = []*string{&calldata.Owner}
+	case transfer:
+		addresses, amount = []*string{&calldata.To}, true
+	case approve:
+		addresses, amount = []*string{&calldata.Spender}, true
+	case allowance:
+		addresses = []*string{&calldata.Owner, &calldata.Spender}
+	case transferFrom:
+		addresses, amount = []*string{&calldata.From, &calldata.To}, true
+	}
+
+	words := len(addresses)
+	if amount {
+		words++
+	}
+	if len(args) != words*32 {
+		return nil, fmt.Errorf("%w: %s takes %d bytes of arguments, got %d", utils.ErrCalldata, signature, words*32, len(args))
+	}
+
+	for i, address := range addresses {
+		var err error
+		if *address, err = decodeAddress(args[i*32 : (i+1)*32]); err != nil {
+			return nil, err
+		}
+	}
+	if amount {
+		calldata.Amount = new(big.Int).SetBytes(args[len(addresses)*32:])
+	}
+	return calldata, nil
+}
+
+// lookupSelector returns the signature of the given selector of 4 bytes.
+func lookupSelector(selector []byte) (string, bool) {
+	for signature, methodID := range erc20methodIDs {
+		if bytes.Equal(methodID[:], selector) {
+			return signature, true
+		}
+	}
+	return "", false
+}
+
+// decodeAddress decodes the address left padded to the given word of 32 bytes.
+func decodeAddress(word []byte) (string, error) {
+	if !bytes.Equal(word[:12], make([]byte, 12)) {
+		return "", fmt.Errorf("%w: address 0x%x has a non-zero padding", utils.ErrCalldata, word)
+	}
+	return utils.ToChecksumAddress("0x" + hex.EncodeToString(word[12:]))
+}
@@ -0,0 +1,133 @@
+package erc20
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+var owner string = "0xcf3aa1a77fa8c221f80bd15f4d7a36186eeb7df1"
+
+func TestDecodeCalldata(t *testing.T) {
+	erc20clause, err := createERC20Clause()
+	if err != nil {
+		t.Errorf("cannot create erc20clause: %v", err)
+	}
+
+	transferPayload, _ := erc20clause.TokenTranfer()
+	approvePayload, _ := erc20clause.TokenApprove()
+	transferFromPayload, _ := erc20clause.TokenTransferFrom(owner)
+	allowancePayload, _ := erc20clause.TokenAllowance(owner)
+	balancePayload, _ := erc20clause.TokenBalance()
+
+	tests := []struct {
+		payload  []byte
+		expected Calldata
+	}{
+		{transferPayload, Calldata{Method: "transfer", Signature: transfer, To: address}},
+		{approvePayload, Calldata{Method: "approve", Signature: approve, Spender: address}},
+		{transferFromPayload, Calldata{Method: "transferFrom", Signature: transferFrom, From: address, To: owner}},
+		{allowancePayload, Calldata{Method: "allowance", Signature: allowance, Owner: address, Spender: owner}},
+		{balancePayload, Calldata{Method: "balanceOf", Signature: balance, Owner: address}},
+		{erc20clause.TokenDecimals(), Calldata{Method: "decimals", Signature: decimals}},
+	}
+
+	for _, test := range tests {
+		calldata, err := DecodeCalldata(test.payload)
+		if err != nil {
+			t.Errorf("cannot decode calldata: %v", err)
+			continue
+		}
+
+		if calldata.Method != test.expected.Method || calldata.Signature != test.expected.Signature ||
+			!strings.EqualFold(calldata.To, test.expected.To) ||
+			!strings.EqualFold(calldata.From, test.expected.From) ||
+			!strings.EqualFold(calldata.Owner, test.expected.Owner) ||
+			!strings.EqualFold(calldata.Spender, test.expected.Spender) {
+			t.Errorf("got %v, wanted %v", calldata, test.expected)
+		}
+
+		// the decoded arguments encode the same payload again.
+		payload, err := reencode(calldata)
+		if err != nil || string(payload) != string(test.payload) {
+			t.Errorf("got %x, wanted %x", payload, test.payload)
+		}
+	}
+
+	calldata, _ := DecodeCalldata(transferPayload)
+	if calldata.Amount.String() != "3" || !utils.IsValidChecksumAddress(calldata.To) {
+		t.Errorf("got %v, wanted %v", calldata.Amount, 3)
+	}
+
+	calldata, _ = DecodeCalldata(transferFromPayload)
+	if calldata.Amount.String() != "3" {
+		t.Errorf("got %v, wanted %v", calldata.Amount, 3)
+	}
+}
+
+func TestDecodeCalldataInvalid(t *testing.T) {
+	erc20clause, err := createERC20Clause()
+	if err != nil {
+		t.Errorf("cannot create erc20clause: %v", err)
+	}
+
+	transferPayload, _ := erc20clause.TokenTranfer()
+	padded := append([]byte{}, transferPayload...)
+	padded[4] = 1
+
+	tests := []struct {
+		data []byte
+		err  error
+	}{
+		{nil, utils.ErrCalldata},
+		{[]byte{0xa9, 0x05, 0x9c}, utils.ErrCalldata},
+		{[]byte{0xde, 0xad, 0xbe, 0xef}, utils.ErrSelector},
+		{transferPayload[:len(transferPayload)-1], utils.ErrCalldata},
+		{append(transferPayload, 0), utils.ErrCalldata},
+		{padded, utils.ErrCalldata},
+	}
+
+	for _, test := range tests {
+		if _, err := DecodeCalldata(test.data); !errors.Is(err, test.err) {
+			t.Errorf("got %v, wanted %v", err, test.err)
+		}
+	}
+}
+
+// reencode encodes the payload of the given decoded calldata.
+func reencode(calldata *Calldata) ([]byte, error) {
+	value := "0"
+	if calldata.Amount != nil {
+		value = calldata.Amount.String()
+	}
+
+	// the first address of the method is the one added by AddToAddress.
+	to := calldata.To
+	switch calldata.Method {
+	case "approve":
+		to = calldata.Spender
+	case "transferFrom":
+		to = calldata.From
+	case "allowance", "balanceOf":
+		to = calldata.Owner
+	}
+
+	body := New().AddValue(value).AddTokenAddress(contractaddress)
+	if to != "" {
+		body.AddToAddress(to)
+	}
+	erc20clause, err := body.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	switch calldata.Method {
+	case "transferFrom":
+		return erc20clause.TokenTransferFrom(calldata.To)
+	case "allowance":
+		return erc20clause.TokenAllowance(calldata.Spender)
+	}
+	return erc20clause.GetERCPayloadData(calldata.Method)
+}
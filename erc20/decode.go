@@ -0,0 +1,102 @@
+package erc20
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+// DecodeName decodes the return data of the ERC-20-based name getter.
+func DecodeName(data []byte) (string, error) {
+	return decodeString(data)
+}
+
+// DecodeSymbol decodes the return data of the ERC-20-based symbol getter.
+func DecodeSymbol(data []byte) (string, error) {
+	return decodeString(data)
+}
+
+// DecodeDecimals decodes the return data of the ERC-20-based decimals getter.
+func DecodeDecimals(data []byte) (uint8, error) {
+	value, err := decodeUint(data)
+	if err != nil {
+		return 0, err
+	}
+
+	if !value.IsUint64() || value.Uint64() > 255 {
+		return 0, fmt.Errorf("%w: decimals %s exceeds uint8", utils.ErrReturnDataFormat, value)
+	}
+	return uint8(value.Uint64()), nil
+}
+
+// DecodeTotalSupply decodes the return data of the ERC-20-based totalSupply getter.
+func DecodeTotalSupply(data []byte) (*big.Int, error) {
+	return decodeUint(data)
+}
+
+// DecodeBalance decodes the return data of the ERC-20-based balanceOf getter.
+func DecodeBalance(data []byte) (*big.Int, error) {
+	return decodeUint(data)
+}
+
+// DecodeAllowance decodes the return data of the ERC-20-based allowance getter.
+func DecodeAllowance(data []byte) (*big.Int, error) {
+	return decodeUint(data)
+}
+
+// StoreTokenDecimals decodes the return data of the ERC-20-based decimals getter and
+// stores the decimals of its token in ER20TokenDecimals.
+func (erc *ERC20Clause) StoreTokenDecimals(data []byte) (uint8, error) {
+	decimals, err := DecodeDecimals(data)
+	if err != nil {
+		return 0, err
+	}
+
+	ER20TokenDecimals[strings.ToLower(erc.tokenAddress.String())] = decimals
+	return decimals, nil
+}
+
+// decodeUint decodes the return data of a single uint256 value.
+func decodeUint(data []byte) (*big.Int, error) {
+	if len(data) != 32 {
+		return nil, fmt.Errorf("%w: got %d bytes, wanted 32", utils.ErrReturnDataLength, len(data))
+	}
+	return new(big.Int).SetBytes(data), nil
+}
+
+// decodeString decodes the return data of a single string value. Some early tokens
+// return their name and symbol as bytes32, which is decoded as well.
+func decodeString(data []byte) (string, error) {
+	if len(data) == 32 {
+		str := string(bytes.TrimRight(data, "\x00"))
+		if !utf8.ValidString(str) {
+			return "", fmt.Errorf("%w: string is not valid utf-8", utils.ErrReturnDataFormat)
+		}
+		return str, nil
+	}
+
+	if len(data) < 64 || len(data)%32 != 0 {
+		return "", fmt.Errorf("%w: got %d bytes for a string", utils.ErrReturnDataLength, len(data))
+	}
+
+	offset := new(big.Int).SetBytes(data[:32])
+	if !offset.IsUint64() || offset.Uint64()%32 != 0 || offset.Uint64() > uint64(len(data)-32) {
+		return "", fmt.Errorf("%w: invalid string offset %s", utils.ErrReturnDataFormat, offset)
+	}
+
+	start := offset.Uint64() + 32
+	length := new(big.Int).SetBytes(data[start-32 : start])
+	if !length.IsUint64() || length.Uint64() > uint64(len(data))-start {
+		return "", fmt.Errorf("%w: string length %s exceeds the return data", utils.ErrReturnDataLength, length)
+	}
+
+	str := string(data[start : start+length.Uint64()])
+	if !utf8.ValidString(str) {
+		return "", fmt.Errorf("%w: string is not valid utf-8", utils.ErrReturnDataFormat)
+	}
+	return str, nil
+}
@@ -0,0 +1,127 @@
+package erc20
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+func decodeHex(t *testing.T, data string) []byte {
+	decoded, err := hex.DecodeString(data)
+	if err != nil {
+		t.Fatalf("cannot decode hex: %v", err)
+	}
+	return decoded
+}
+
+func TestDecodeName(t *testing.T) {
+	data := decodeHex(t, "0000000000000000000000000000000000000000000000000000000000000020"+
+		"000000000000000000000000000000000000000000000000000000000000000a"+
+		"5465746865722055534400000000000000000000000000000000000000000000")
+
+	name, err := DecodeName(data)
+	if err != nil {
+		t.Errorf("cannot decode name: %v", err)
+	}
+
+	if name != "Tether USD" {
+		t.Errorf("got %v, wanted %v", name, "Tether USD")
+	}
+}
+
+func TestDecodeSymbolBytes32(t *testing.T) {
+	data := decodeHex(t, "4d4b520000000000000000000000000000000000000000000000000000000000")
+
+	symbol, err := DecodeSymbol(data)
+	if err != nil {
+		t.Errorf("cannot decode symbol: %v", err)
+	}
+
+	if symbol != "MKR" {
+		t.Errorf("got %v, wanted %v", symbol, "MKR")
+	}
+}
+
+func TestDecodeStringInvalid(t *testing.T) {
+	tests := map[string]error{
+		"":   utils.ErrReturnDataLength,
+		"00": utils.ErrReturnDataLength,
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+			"00000000000000000000000000000000000000000000000000000000000000ff": utils.ErrReturnDataLength,
+		"0000000000000000000000000000000000000000000000000000000000000040" +
+			"000000000000000000000000000000000000000000000000000000000000000a": utils.ErrReturnDataFormat,
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+			"0000000000000000000000000000000000000000000000000000000000000002" +
+			"ffff000000000000000000000000000000000000000000000000000000000000": utils.ErrReturnDataFormat,
+	}
+
+	for data, expected := range tests {
+		_, err := DecodeName(decodeHex(t, data))
+		if !errors.Is(err, expected) {
+			t.Errorf("got %v, wanted %v", err, expected)
+		}
+	}
+}
+
+func TestDecodeDecimals(t *testing.T) {
+	decimals, err := DecodeDecimals(decodeHex(t, "0000000000000000000000000000000000000000000000000000000000000012"))
+	if err != nil {
+		t.Errorf("cannot decode decimals: %v", err)
+	}
+
+	if decimals != 18 {
+		t.Errorf("got %v, wanted %v", decimals, 18)
+	}
+
+	_, err = DecodeDecimals(decodeHex(t, "0000000000000000000000000000000000000000000000000000000000000100"))
+	if !errors.Is(err, utils.ErrReturnDataFormat) {
+		t.Errorf("got %v, wanted %v", err, utils.ErrReturnDataFormat)
+	}
+
+	_, err = DecodeDecimals(decodeHex(t, "12"))
+	if !errors.Is(err, utils.ErrReturnDataLength) {
+		t.Errorf("got %v, wanted %v", err, utils.ErrReturnDataLength)
+	}
+}
+
+func TestDecodeBalance(t *testing.T) {
+	data := decodeHex(t, "000000000000000000000000000000000000000000000002b5e3af16b1880000")
+	expected, _ := new(big.Int).SetString("50000000000000000000", 10)
+
+	for _, decode := range []func([]byte) (*big.Int, error){DecodeBalance, DecodeAllowance, DecodeTotalSupply} {
+		value, err := decode(data)
+		if err != nil {
+			t.Errorf("cannot decode value: %v", err)
+		}
+
+		if value.Cmp(expected) != 0 {
+			t.Errorf("got %v, wanted %v", value, expected)
+		}
+	}
+
+	_, err := DecodeBalance(data[1:])
+	if !errors.Is(err, utils.ErrReturnDataLength) {
+		t.Errorf("got %v, wanted %v", err, utils.ErrReturnDataLength)
+	}
+}
+
+func TestStoreTokenDecimals(t *testing.T) {
+	erc20clause, err := createERC20Clause()
+	if err != nil {
+		t.Errorf("cannot create erc20clause: %v", err)
+	}
+
+	_, err = erc20clause.StoreTokenDecimals(decodeHex(t, "0000000000000000000000000000000000000000000000000000000000000006"))
+	if err != nil {
+		t.Errorf("cannot store decimals: %v", err)
+	}
+
+	decimals := ER20TokenDecimals[strings.ToLower(contractaddress)]
+	if decimals != 6 {
+		t.Errorf("got %v, wanted %v", decimals, 6)
+	}
+}
@@ -1,9 +1,6 @@
 package erc20
 
 import (
-	"errors"
-	"math/big"
-
 	"github.com/mirzazhar/golang-transfer-clause/utils"
 )
 
@@ -17,8 +14,13 @@ type ERC20Transform interface {
 // ERC20Body holds the necessary transfer information to be used by a
 // transaction that will interact with the ERC20-based token standard.
 type ERC20Body struct {
-	to, value, data string
-	tokenAddress    string
+	to, tokenAddress, data utils.Address
+	value, amount          string
+	strict                 bool
+
+	// rawTo, rawTokenAddress and rawData hold the addresses as given to the
+	// setters; Build parses them once into to, tokenAddress and data.
+	rawTo, rawTokenAddress, rawData string
 }
 
 // New creates and returns an empty instance of ERC20Body.
@@ -28,7 +30,13 @@ func New() *ERC20Body {
 
 // AddToAddress method adds the recipient address to its instance.
 func (eb *ERC20Body) AddToAddress(to string) *ERC20Body {
-	eb.to = to
+	eb.rawTo = to
+	return eb
+}
+
+// AddTo method adds the parsed recipient address to its instance.
+func (eb *ERC20Body) AddTo(to utils.Address) *ERC20Body {
+	eb.to, eb.rawTo = to, to.String()
 	return eb
 }
 
@@ -38,11 +46,32 @@ func (eb *ERC20Body) AddValue(value string) *ERC20Body {
 	return eb
 }
 
+// AddInteger method adds the "amount to be transferred" as the integer value of
+// base units, e.g. created by utils.NewUint256, to its instance. Build rejects
+// the negative value, and the nil value leaves the value empty.
+func (eb *ERC20Body) AddInteger(value *utils.Integer) *ERC20Body {
+	if value == nil {
+		eb.value = ""
+		return eb
+	}
+	eb.value = value.String()
+	return eb
+}
+
+// AddAmount method adds the human-readable "amount to be transferred", e.g.
+// "12.345", to its instance. Build converts it into the exact value of base
+// units using the decimals of the token registered in ER20TokenDecimals, and
+// it takes precedence over the value added by AddValue.
+func (eb *ERC20Body) AddAmount(amount string) *ERC20Body {
+	eb.amount = amount
+	return eb
+}
+
 // Init creates an instance of ERC20Body using any type that implements
 // ERC20Transform interface.
 func Init(erc20 ERC20Transform) *ERC20Body {
 	return &ERC20Body{
-		to:    erc20.GetToAddress(),
+		rawTo: erc20.GetToAddress(),
 		value: erc20.GetValue(),
 	}
 }
@@ -50,28 +79,114 @@ func Init(erc20 ERC20Transform) *ERC20Body {
 // AddTokenAddress adds the contract address of the ERC20-based standard
 // token.
 func (eb *ERC20Body) AddTokenAddress(tokenAddr string) *ERC20Body {
-	eb.tokenAddress = tokenAddr
+	eb.rawTokenAddress = tokenAddr
+	return eb
+}
+
+// AddToken adds the parsed contract address of the ERC20-based standard token.
+func (eb *ERC20Body) AddToken(token utils.Address) *ERC20Body {
+	eb.tokenAddress, eb.rawTokenAddress = token, token.String()
 	return eb
 }
 
 // AddData adds an account address. Later on, this address will use as a
 // parameter for ERC20-based token methods: approve and tokentransferfrom.
 func (eb *ERC20Body) AddData(data string) *ERC20Body {
-	eb.data = data
+	eb.rawData = data
+	return eb
+}
+
+// AddDataAddress adds the parsed account address used as a parameter, like
+// AddData.
+func (eb *ERC20Body) AddDataAddress(data utils.Address) *ERC20Body {
+	eb.data, eb.rawData = data, data.String()
 	return eb
 }
 
+// AddStrictChecksum enables or disables the strict validation of the EIP-55
+// checksum. Once enabled, Build rejects the mixed-case token, recipient or data
+// address whose checksum is wrong.
+func (eb *ERC20Body) AddStrictChecksum(strict bool) *ERC20Body {
+	eb.strict = strict
+	return eb
+}
+
+// Validate validates its underlying instance like Build, and returns
+// utils.ValidationErrors of all the failing fields, or nil if it is valid.
+func (b *ERC20Body) Validate() error {
+	if _, errs := b.validate(); len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
 // Build validates its underlying instance and then creates the
-// new instance of ERC20Clause.
+// new instance of ERC20Clause. It returns utils.ValidationErrors of all the
+// failing fields.
 func (b *ERC20Body) Build() (*ERC20Clause, error) {
-	if !utils.IsValidAddress(b.tokenAddress) {
-		return nil, utils.ErrTokenAddress
-	} else if b.tokenAddress == b.to {
-		return nil, utils.ErrSameEOAContractAddr
-	} else if !utils.IsValidDecimalValue(b.value) {
-		return nil, utils.ErrValue
+	body, errs := b.validate()
+	if len(errs) > 0 {
+		return nil, errs
 	}
-	return &ERC20Clause{ERC20Body: *b}, nil
+	return &ERC20Clause{ERC20Body: body}, nil
+}
+
+// validate validates every field of its underlying instance and returns the
+// body holding the parsed addresses and the value of base units along with
+// the errors in the order the fields are validated. The recipient and data
+// addresses are optional, e.g. for the getters, but must be valid if given.
+func (b *ERC20Body) validate() (ERC20Body, utils.ValidationErrors) {
+	body := *b
+	var errs utils.ValidationErrors
+
+	var err error
+	if body.tokenAddress, err = utils.ParseAddress(b.rawTokenAddress); err != nil {
+		errs = append(errs, utils.NewValidationError(utils.FieldTokenAddress, b.rawTokenAddress, utils.ErrTokenAddress))
+	}
+	if b.rawTo != "" {
+		if body.to, err = utils.ParseAddress(b.rawTo); err != nil {
+			errs = append(errs, utils.NewValidationError(utils.FieldTo, b.rawTo, utils.ErrToAddress))
+		} else if len(errs) == 0 && body.to == body.tokenAddress {
+			errs = append(errs, utils.NewValidationError(utils.FieldTo, b.rawTo, utils.ErrSameEOAContractAddr))
+		}
+	}
+	if b.rawData != "" {
+		if body.data, err = utils.ParseAddress(b.rawData); err != nil {
+			errs = append(errs, utils.NewValidationError(utils.FieldData, b.rawData, err))
+		}
+	}
+
+	// the value is validated only if it is not replaced by an invalid amount.
+	validAmount := true
+	if b.amount != "" {
+		if decimals, ok := LookupTokenDecimals(b.rawTokenAddress); !ok {
+			errs = append(errs, utils.NewValidationError(utils.FieldValue, b.amount, utils.ErrUnknownDecimals))
+			validAmount = false
+		} else if value, err := utils.ParseUnits(b.amount, decimals); err != nil {
+			errs = append(errs, utils.NewValidationError(utils.FieldValue, b.amount, err))
+			validAmount = false
+		} else {
+			body.value = value.String()
+		}
+	}
+
+	if validAmount {
+		if !utils.IsValidDecimalValue(body.value) {
+			errs = append(errs, utils.NewValidationError(utils.FieldValue, body.value, utils.ErrValue))
+		} else if _, err := utils.ParseUint256(body.value); err != nil {
+			errs = append(errs, utils.NewValidationError(utils.FieldValue, body.value, err))
+		}
+	}
+
+	if b.strict {
+		fields := []string{utils.FieldTokenAddress, utils.FieldTo, utils.FieldData}
+		for i, address := range []string{b.rawTokenAddress, b.rawTo, b.rawData} {
+			if utils.IsValidAddress(address) && !utils.IsValidChecksumAddress(address) {
+				errs = append(errs, utils.NewValidationError(fields[i], address, utils.ErrAddressChecksum))
+			}
+		}
+	}
+	return body, errs
 }
 
 // ERC20Clause represents the transfer information for the ERC-20 standard used by
@@ -80,14 +195,33 @@ type ERC20Clause struct {
 	ERC20Body
 }
 
-// GetTokenAddress returns the contract address of the ERC-20 standard token.
+// GetTokenAddress returns the contract address of the ERC-20 standard token as
+// it was given.
 func (erc *ERC20Clause) GetTokenAddress() string {
+	return erc.rawTokenAddress
+}
+
+// GetToken returns the parsed contract address of the ERC-20 standard token.
+func (erc *ERC20Clause) GetToken() utils.Address {
 	return erc.tokenAddress
 }
 
-// GetToAddress returns the receiver address for the ERC-20 standard token.
+// GetToAddress returns the receiver address for the ERC-20 standard token as
+// it was given.
 func (erc *ERC20Clause) GetToAddress() string {
-	return erc.to
+	return erc.rawTo
+}
+
+// GetTo returns the parsed receiver address for the ERC-20 standard token. It
+// reports false if no receiver address was given.
+func (erc *ERC20Clause) GetTo() (utils.Address, bool) {
+	return erc.to, erc.rawTo != ""
+}
+
+// GetValue returns the amount of base units to be transferred for the ERC-20
+// standard token.
+func (erc *ERC20Clause) GetValue() string {
+	return erc.value
 }
 
 // TokenName returns the payload of the token name for the ERC-20-based getter.
@@ -141,47 +275,71 @@ func (erc *ERC20Clause) TokenApprove() ([]byte, error) {
 // TokenTransferFrom returns the payload of "token transfer from the address of token
 // approved to another address" for the ERC-20-based method.
 func (erc *ERC20Clause) TokenTransferFrom(from string) ([]byte, error) {
-	payload, err := erc.payload(transferFrom)
+	address, err := parseDataAddress(from)
 	if err != nil {
 		return nil, err
 	}
+	return erc.transferFrom(address)
+}
 
-	toaddress, err := utils.AddresstoBytes(from)
+// transferFrom returns the payload of TokenTransferFrom of the given parsed
+// address.
+func (erc *ERC20Clause) transferFrom(from utils.Address) ([]byte, error) {
+	payload, err := erc.payload(transferFrom)
 	if err != nil {
 		return nil, err
 	}
 
-	paddedAddress := utils.LeftPadBytes(toaddress, 32)
-	payload = append(payload, paddedAddress...)
+	payload = append(payload, utils.LeftPadBytes(from.Bytes(), 32)...)
 	return erc.extendPayload(payload)
 }
 
 // TokenAllowance returns the payload to find the remaining number of allowed tokens for
 // the ERC-20-based getters.
 func (erc *ERC20Clause) TokenAllowance(owner string) ([]byte, error) {
-	payload, err := erc.payload(allowance)
+	address, err := parseDataAddress(owner)
 	if err != nil {
 		return nil, err
 	}
+	return erc.allowance(address)
+}
 
-	toaddress, err := utils.AddresstoBytes(owner)
+// allowance returns the payload of TokenAllowance of the given parsed address.
+func (erc *ERC20Clause) allowance(owner utils.Address) ([]byte, error) {
+	payload, err := erc.payload(allowance)
 	if err != nil {
 		return nil, err
 	}
+	return append(payload, utils.LeftPadBytes(owner.Bytes(), 32)...), nil
+}
 
-	paddedAddress := utils.LeftPadBytes(toaddress, 32)
-	return append(payload, paddedAddress...), nil
+// parseDataAddress parses the given address argument like AddData, and
+// reports its failure as the error of the data field.
+func parseDataAddress(address string) (utils.Address, error) {
+	data, err := utils.ParseAddress(address)
+	if err != nil {
+		return data, utils.NewValidationError(utils.FieldData, address, err)
+	}
+	return data, nil
+}
+
+// dataAddress returns the parsed data address of the clause, i.e., the
+// argument of transferFrom and allowance.
+func (erc *ERC20Clause) dataAddress() (utils.Address, error) {
+	if erc.rawData == "" {
+		return utils.Address{}, utils.NewValidationError(utils.FieldData, "", utils.ErrAddress)
+	}
+	return erc.data, nil
 }
 
 // payload creates the actual data array to be used to interact with the ERC-20-based
 // token standard.
 func (erc *ERC20Clause) payload(method string) ([]byte, error) {
-	address, err := utils.AddresstoBytes(erc.to)
-	if err != nil {
-		return nil, err
+	if erc.rawTo == "" {
+		return nil, utils.NewValidationError(utils.FieldTo, "", utils.ErrToAddress)
 	}
 
-	paddedAddress := utils.LeftPadBytes(address, 32)
+	paddedAddress := utils.LeftPadBytes(erc.to.Bytes(), 32)
 	methodID := erc20methodIDs[method]
 
 	var data []byte
@@ -193,14 +351,11 @@ func (erc *ERC20Clause) payload(method string) ([]byte, error) {
 // extendPayload extends the functionality of the payload method, particularly when three
 // or more values are required to complete the payload for the ERC-20-based token standard.
 func (erc *ERC20Clause) extendPayload(payload []byte) ([]byte, error) {
-	amount, ok := new(big.Int).SetString(erc.value, 10)
-	if !ok {
-		return nil, errors.New("error in converting string based value to big integers")
+	amount, err := utils.ParseUint256(erc.value)
+	if err != nil {
+		return nil, utils.NewValidationError(utils.FieldValue, erc.value, err)
 	}
-
-	paddedAmount := utils.LeftPadBytes(amount.Bytes(), 32)
-	payload = append(payload, paddedAmount...)
-	return payload, nil
+	return append(payload, amount.Word()...), nil
 }
 
 // GetERCPayloadData returns the payload of the given method in a byte array. Moreover, purposely,
@@ -234,17 +389,23 @@ func (erc *ERC20Clause) GetERCPayloadData(method string) ([]byte, error) {
 			return nil, err
 		}
 	case "transferFrom":
-		data, err = erc.TokenTransferFrom(erc.data)
+		from, err := erc.dataAddress()
 		if err != nil {
 			return nil, err
 		}
+		if data, err = erc.transferFrom(from); err != nil {
+			return nil, err
+		}
 	case "allowance":
-		data, err = erc.TokenAllowance(erc.data)
+		owner, err := erc.dataAddress()
 		if err != nil {
 			return nil, err
 		}
+		if data, err = erc.allowance(owner); err != nil {
+			return nil, err
+		}
 	default:
-		return nil, errors.New("this method is not defined :" + method)
+		return nil, utils.NewValidationError(utils.FieldMethod, method, utils.ErrMethod)
 	}
 	return data, nil
 }
@@ -2,8 +2,13 @@ package erc20
 
 import (
 	"encoding/hex"
+	"errors"
+	"math/big"
 	"reflect"
+	"strings"
 	"testing"
+
+	"github.com/mirzazhar/golang-transfer-clause/utils"
 )
 
 var (
@@ -26,11 +31,15 @@ func TestCreateClause(t *testing.T) {
 		t.Errorf("cannot create erc20clause: %v", err)
 	}
 
+	to, _ := utils.ParseAddress(address)
+	token, _ := utils.ParseAddress(contractaddress)
 	expectederc20clause := &ERC20Clause{
 		ERC20Body{
-			to:           address,
-			value:        "3",
-			tokenAddress: contractaddress,
+			to:              to,
+			value:           "3",
+			tokenAddress:    token,
+			rawTo:           address,
+			rawTokenAddress: contractaddress,
 		},
 	}
 
@@ -196,3 +205,210 @@ func TestAllowance(t *testing.T) {
 		t.Errorf("got %v, wanted %v", hexvaluepayload, expected)
 	}
 }
+
+func TestCreateClauseStrictChecksum(t *testing.T) {
+	_, err := New().
+		AddToAddress(address).
+		AddValue("3").
+		AddTokenAddress(contractaddress).
+		AddStrictChecksum(true).
+		Build()
+	if err != nil {
+		t.Errorf("cannot create erc20clause: %v", err)
+	}
+
+	typoaddresses := []string{
+		"0xf6fe970533fe5c63d196139B14522Eb2956f8621",
+		"0xF6fe970533fe5C63d196139B14522Eb2956f8621",
+	}
+	for _, typoaddress := range typoaddresses {
+		_, err = New().
+			AddToAddress(address).
+			AddValue("3").
+			AddTokenAddress(typoaddress).
+			AddStrictChecksum(true).
+			Build()
+		if !errors.Is(err, utils.ErrAddressChecksum) {
+			t.Errorf("got %v, wanted %v", err, utils.ErrAddressChecksum)
+		}
+	}
+
+	_, err = New().
+		AddToAddress("0x27D22890587cfaDA7fec247C5180D73dE6C670C4").
+		AddValue("3").
+		AddTokenAddress(contractaddress).
+		AddStrictChecksum(true).
+		Build()
+	if !errors.Is(err, utils.ErrAddressChecksum) {
+		t.Errorf("got %v, wanted %v", err, utils.ErrAddressChecksum)
+	}
+}
+
+func TestCreateClauseWithAmount(t *testing.T) {
+	ER20TokenDecimals[strings.ToLower(contractaddress)] = 6
+	defer delete(ER20TokenDecimals, strings.ToLower(contractaddress))
+
+	erc20clause, err := New().
+		AddToAddress(address).
+		AddAmount("12.345").
+		AddTokenAddress(contractaddress).
+		Build()
+	if err != nil {
+		t.Errorf("cannot create erc20clause: %v", err)
+	}
+
+	if erc20clause.GetValue() != "12345000" {
+		t.Errorf("got %v, wanted %v", erc20clause.GetValue(), "12345000")
+	}
+
+	_, err = New().
+		AddToAddress(address).
+		AddAmount("0.0000001").
+		AddTokenAddress(contractaddress).
+		Build()
+	if !errors.Is(err, utils.ErrDecimalPrecision) {
+		t.Errorf("got %v, wanted %v", err, utils.ErrDecimalPrecision)
+	}
+}
+
+func TestCreateClauseWithAmountUnknownDecimals(t *testing.T) {
+	_, err := New().
+		AddToAddress(address).
+		AddAmount("1.5").
+		AddTokenAddress("0x0bf4A8E0D09C3B16Bb6B90362Bc4218589b0a567").
+		Build()
+	if !errors.Is(err, utils.ErrUnknownDecimals) {
+		t.Errorf("got %v, wanted %v", err, utils.ErrUnknownDecimals)
+	}
+}
+
+func TestCreateClauseWithAddress(t *testing.T) {
+	to, _ := utils.ParseAddress(address)
+	token, _ := utils.ParseAddress(contractaddress)
+
+	erc20clause, err := New().AddTo(to).AddValue("3").AddToken(token).AddDataAddress(to).AddStrictChecksum(true).Build()
+	if err != nil {
+		t.Fatalf("cannot create erc20clause: %v", err)
+	}
+	if got, wanted := erc20clause.GetToAddress(), to.String(); got != wanted {
+		t.Errorf("got %v, wanted %v", got, wanted)
+	}
+
+	payload, _ := erc20clause.TokenTranfer()
+	expected, _ := createERC20Clause()
+	expectedpayload, _ := expected.TokenTranfer()
+	if !reflect.DeepEqual(payload, expectedpayload) {
+		t.Errorf("got %x, wanted %x", payload, expectedpayload)
+	}
+}
+
+func TestCreateClauseSameAddressIgnoresCase(t *testing.T) {
+	_, err := New().AddToAddress(strings.ToLower(contractaddress)).AddValue("3").AddTokenAddress(contractaddress).Build()
+	if !errors.Is(err, utils.ErrSameEOAContractAddr) {
+		t.Errorf("got %v, wanted %v", err, utils.ErrSameEOAContractAddr)
+	}
+}
+
+func TestCreateClauseOutOfRange(t *testing.T) {
+	// 2^256 does not fit in the uint256 amount.
+	value := "115792089237316195423570985008687907853269984665640564039457584007913129639936"
+	_, err := New().AddToAddress(address).AddValue(value).AddTokenAddress(contractaddress).Build()
+	if !errors.Is(err, utils.ErrValueOutOfRange) {
+		t.Errorf("got %v, wanted %v", err, utils.ErrValueOutOfRange)
+	}
+}
+
+func TestCreateClauseWithInteger(t *testing.T) {
+	value, _ := utils.NewUint256(big.NewInt(3))
+	erc20clause, err := New().AddToAddress(address).AddInteger(value).AddTokenAddress(contractaddress).Build()
+	if err != nil {
+		t.Fatalf("cannot create erc20clause: %v", err)
+	}
+	if got, wanted := erc20clause.GetValue(), "3"; got != wanted {
+		t.Errorf("got %v, wanted %v", got, wanted)
+	}
+
+	negative, _ := utils.NewInteger(big.NewInt(-3), 256, true)
+	if _, err := New().AddToAddress(address).AddInteger(negative).AddTokenAddress(contractaddress).Build(); !errors.Is(err, utils.ErrValue) {
+		t.Errorf("got %v, wanted %v", err, utils.ErrValue)
+	}
+	if _, err := New().AddToAddress(address).AddInteger(nil).AddTokenAddress(contractaddress).Build(); !errors.Is(err, utils.ErrValue) {
+		t.Errorf("got %v, wanted %v", err, utils.ErrValue)
+	}
+}
+
+func TestCreateClauseValidationErrors(t *testing.T) {
+	body := New().
+		AddToAddress("0x27D22890587CFADA7fec247c5180d73de6c670c4").
+		AddValue("1.5").
+		AddTokenAddress("0xf6fe97").
+		AddStrictChecksum(true)
+
+	// errors.Is matches the sentinel error of every failing field.
+	_, err := body.Build()
+	for _, sentinel := range []error{utils.ErrTokenAddress, utils.ErrValue, utils.ErrAddressChecksum} {
+		if !errors.Is(err, sentinel) {
+			t.Errorf("got %v, wanted %v", err, sentinel)
+		}
+	}
+
+	var errs utils.ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("got %v, wanted %T", err, errs)
+	}
+
+	wanted := []struct{ field, code string }{
+		{utils.FieldTokenAddress, utils.CodeInvalidAddress},
+		{utils.FieldValue, utils.CodeInvalidValue},
+		{utils.FieldTo, utils.CodeAddressChecksum},
+	}
+	if len(errs) != len(wanted) {
+		t.Fatalf("got %v, wanted %v errors", errs, len(wanted))
+	}
+	for i, w := range wanted {
+		if errs[i].Field != w.field || errs[i].Code != w.code {
+			t.Errorf("got %v %v, wanted %v %v", errs[i].Field, errs[i].Code, w.field, w.code)
+		}
+	}
+
+	if err := body.Validate(); !errors.Is(err, utils.ErrAddressChecksum) {
+		t.Errorf("got %v, wanted %v", err, utils.ErrAddressChecksum)
+	}
+	if err := New().AddToAddress(address).AddValue("3").AddTokenAddress(contractaddress).Validate(); err != nil {
+		t.Errorf("got %v, wanted %v", err, nil)
+	}
+}
+
+func TestGetERCPayloadDataUnknownMethod(t *testing.T) {
+	erc20clause, _ := createERC20Clause()
+
+	_, err := erc20clause.GetERCPayloadData("mint")
+	var validationErr *utils.ValidationError
+	if !errors.As(err, &validationErr) || validationErr.Code != utils.CodeUnknownMethod || validationErr.Input != "mint" {
+		t.Errorf("got %v, wanted %v", err, utils.ErrMethod)
+	}
+}
+
+func TestTransferFromInvalidAddress(t *testing.T) {
+	erc20clause, _ := createERC20Clause()
+
+	// the addresses are parsed like AddData, i.e., with prefix 0x only.
+	addresses := []string{
+		"0xzzf4A8E0D09C3B16Bb6B90362Bc4218589b0a567",
+		"0x0bf4A8E0D09C3B16Bb6B90362Bc4218589b0a",
+		"0bf4A8E0D09C3B16Bb6B90362Bc4218589b0a567",
+		"x",
+	}
+
+	for _, from := range addresses {
+		_, err := erc20clause.TokenTransferFrom(from)
+		var validationErr *utils.ValidationError
+		if !errors.As(err, &validationErr) || validationErr.Err != utils.ErrAddress || validationErr.Code != utils.CodeInvalidAddress {
+			t.Errorf("got %v, wanted %v", err, utils.ErrAddress)
+		}
+
+		if _, err := erc20clause.TokenAllowance(from); !errors.Is(err, utils.ErrAddress) {
+			t.Errorf("got %v, wanted %v", err, utils.ErrAddress)
+		}
+	}
+}
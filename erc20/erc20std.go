@@ -1,7 +1,10 @@
 package erc20
 
 import (
-	"golang.org/x/crypto/sha3"
+	"strings"
+
+	"github.com/mirzazhar/golang-transfer-clause/selector"
+	"github.com/mirzazhar/golang-transfer-clause/utils"
 )
 
 // ERC20-based token standard; getters and functions.
@@ -17,10 +20,12 @@ var (
 	allowance    string = "allowance(address,address)"
 )
 
-// ER20TokenDecimals holds the decimal placing value of the ERC20-based token standard.
+// ER20TokenDecimals holds the decimal placing value of the ERC20-based token standard,
+// keyed by the lower-case token address.
 var ER20TokenDecimals = make(map[string]uint8)
 
-// erc20methodIDs holds the method ID of the ERC20-based token standard.
+// erc20methodIDs holds the method ID of the ERC20-based token standard;
+// its signatures are registered in selector.Default as well.
 var erc20methodIDs = make(map[string][4]byte)
 
 func init() {
@@ -29,18 +34,26 @@ func init() {
 
 	for _, method := range erc20standard {
 		erc20methodIDs[method] = methodID(method)
+		selector.Default.Register(method)
 	}
 }
 
-// methodID calculates and returns the method ID of 4 bytes.
-func methodID(method string) [4]byte {
-	methodbytes := []byte(method)
-	hash := sha3.NewLegacyKeccak256()
-	hash.Write(methodbytes)
+// LookupTokenDecimals returns the decimals of the given token address registered in
+// ER20TokenDecimals. The address is matched regardless of its case.
+func LookupTokenDecimals(tokenAddress string) (uint8, bool) {
+	if decimals, ok := ER20TokenDecimals[strings.ToLower(tokenAddress)]; ok {
+		return decimals, true
+	}
 
-	var methodSignature [4]byte
-	for i, id := range hash.Sum(nil)[:4] {
-		methodSignature[i] = id
+	for address, decimals := range ER20TokenDecimals {
+		if strings.EqualFold(address, tokenAddress) {
+			return decimals, true
+		}
 	}
-	return methodSignature
+	return 0, false
+}
+
+// methodID calculates and returns the method ID of 4 bytes.
+func methodID(method string) [4]byte {
+	return utils.MethodID(method)
 }
@@ -3,6 +3,8 @@ package erc20
 import (
 	"encoding/hex"
 	"testing"
+
+	"github.com/mirzazhar/golang-transfer-clause/selector"
 )
 
 func TestMethodID(t *testing.T) {
@@ -30,3 +32,26 @@ func TestMethodID(t *testing.T) {
 		}
 	}
 }
+
+func TestLookupTokenDecimals(t *testing.T) {
+	ER20TokenDecimals["0xF6FE970533FE5C63D196139B14522EB2956F8621"] = 8
+	defer delete(ER20TokenDecimals, "0xF6FE970533FE5C63D196139B14522EB2956F8621")
+
+	decimals, ok := LookupTokenDecimals("0xf6fe970533fe5C63d196139B14522Eb2956f8621")
+	if !ok || decimals != 8 {
+		t.Errorf("got %v, wanted %v", decimals, 8)
+	}
+
+	_, ok = LookupTokenDecimals("0x27d22890587cfada7fec247c5180d73de6c670c4")
+	if ok {
+		t.Errorf("got %v, wanted %v", ok, false)
+	}
+}
+
+func TestDefaultSelectors(t *testing.T) {
+	for method, id := range erc20methodIDs {
+		if got := selector.Default.Lookup(id); len(got) == 0 || got[0] != method {
+			t.Errorf("got %v, wanted %v", got, method)
+		}
+	}
+}
@@ -0,0 +1,262 @@
+package erc20
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+// ERC20-based token standard; events.
+var (
+	transferEvent string = "Transfer(address,address,uint256)"
+	approvalEvent string = "Approval(address,address,uint256)"
+)
+
+// TransferTopic and ApprovalTopic hold the topic0 of the Transfer and Approval
+// events, i.e., the keccak-256 hash of their signatures hex-encoded with prefix 0x.
+var (
+	TransferTopic string = eventTopic(transferEvent)
+	ApprovalTopic string = eventTopic(approvalEvent)
+)
+
+// eventTopic calculates and returns the topic0 of the given event signature.
+func eventTopic(event string) string {
+	return "0x" + hex.EncodeToString(utils.Keccak256([]byte(event)))
+}
+
+// Log represents the log emitted by a contract in the format of the JSON-RPC
+// API, e.g. as returned by eth_getLogs or within a transaction receipt.
+type Log struct {
+	Address         string   `json:"address"`
+	Topics          []string `json:"topics"`
+	Data            string   `json:"data"`
+	BlockNumber     string   `json:"blockNumber,omitempty"`
+	TransactionHash string   `json:"transactionHash,omitempty"`
+	LogIndex        string   `json:"logIndex,omitempty"`
+	Removed         bool     `json:"removed,omitempty"`
+}
+
+// TransferEvent holds the Transfer event decoded from a log. The addresses are
+// EIP-55 checksummed.
+type TransferEvent struct {
+	Token  string
+	From   string
+	To     string
+	Amount *big.Int
+}
+
+// ApprovalEvent holds the Approval event decoded from a log. The addresses are
+// EIP-55 checksummed.
+type ApprovalEvent struct {
+	Token   string
+	Owner   string
+	Spender string
+	Amount  *big.Int
+}
+
+// DecodeTransferLog decodes the given log of the Transfer event; the sender and
+// the recipient from the indexed topics and the amount from the data. The
+// ERC-721 Transfer event shares its topic0 but indexes the token id as well,
+// so its log is rejected.
+func DecodeTransferLog(log *Log) (*TransferEvent, error) {
+	token, from, to, amount, err := decodeEventLog(log, TransferTopic)
+	if err != nil {
+		return nil, err
+	}
+	return &TransferEvent{Token: token, From: from, To: to, Amount: amount}, nil
+}
+
+// DecodeApprovalLog decodes the given log of the Approval event; the owner and
+// the spender from the indexed topics and the amount from the data.
+func DecodeApprovalLog(log *Log) (*ApprovalEvent, error) {
+	token, owner, spender, amount, err := decodeEventLog(log, ApprovalTopic)
+	if err != nil {
+		return nil, err
+	}
+	return &ApprovalEvent{Token: token, Owner: owner, Spender: spender, Amount: amount}, nil
+}
+
+// decodeEventLog decodes the given log of the event of the given topic0 with
+// two indexed addresses and a non-indexed amount.
+func decodeEventLog(log *Log, topic string) (string, string, string, *big.Int, error) {
+	if log == nil {
+		return "", "", "", nil, fmt.Errorf("%w: log must not be nil", utils.ErrEventLog)
+	} else if len(log.Topics) != 3 {
+		return "", "", "", nil, fmt.Errorf("%w: got %d topics, wanted 3", utils.ErrEventLog, len(log.Topics))
+	} else if !strings.EqualFold(log.Topics[0], topic) {
+		return "", "", "", nil, fmt.Errorf("%w: topic0 %s, wanted %s", utils.ErrEventLog, log.Topics[0], topic)
+	}
+
+	token, err := utils.ToChecksumAddress(log.Address)
+	if err != nil {
+		return "", "", "", nil, fmt.Errorf("%w: invalid contract address %s", utils.ErrEventLog, log.Address)
+	}
+
+	var addresses [2]string
+	for i, topic := range log.Topics[1:] {
+		word, err := decodeWord(topic)
+		if err != nil {
+			return "", "", "", nil, err
+		}
+		if addresses[i], err = decodeAddress(word); err != nil {
+			return "", "", "", nil, fmt.Errorf("%w: topic %s is not an address", utils.ErrEventLog, topic)
+		}
+	}
+
+	data, err := decodeWord(log.Data)
+	if err != nil {
+		return "", "", "", nil, err
+	}
+	return token, addresses[0], addresses[1], new(big.Int).SetBytes(data), nil
+}
+
+// decodeWord decodes the given word of 32 bytes hex-encoded with prefix 0x.
+func decodeWord(word string) ([]byte, error) {
+	decoded, err := hex.DecodeString(strings.TrimPrefix(strings.TrimPrefix(word, "0x"), "0X"))
+	if err != nil || len(decoded) != 32 {
+		return nil, fmt.Errorf("%w: %q is not a hex-encoded word of 32 bytes", utils.ErrEventLog, word)
+	}
+	return decoded, nil
+}
+
+// LogFilterBody holds the criteria of the eth_getLogs query of the Transfer or
+// Approval events: the token addresses, the indexed addresses and the block
+// range. Each criterion matches any of its values, and the criteria left
+// empty match everything.
+type LogFilterBody struct {
+	topic              string
+	tokens, from, to   []string
+	fromBlock, toBlock string
+}
+
+// NewTransferFilter creates and returns an instance of LogFilterBody that
+// queries the Transfer events.
+func NewTransferFilter() *LogFilterBody {
+	return &LogFilterBody{topic: TransferTopic}
+}
+
+// NewApprovalFilter creates and returns an instance of LogFilterBody that
+// queries the Approval events.
+func NewApprovalFilter() *LogFilterBody {
+	return &LogFilterBody{topic: ApprovalTopic}
+}
+
+// AddTokenAddress adds the addresses of the tokens whose events are queried.
+func (lb *LogFilterBody) AddTokenAddress(addresses ...string) *LogFilterBody {
+	lb.tokens = append(lb.tokens, addresses...)
+	return lb
+}
+
+// AddFromAddress adds the addresses of the first indexed topic, i.e., the
+// sender of Transfer and the owner of Approval.
+func (lb *LogFilterBody) AddFromAddress(addresses ...string) *LogFilterBody {
+	lb.from = append(lb.from, addresses...)
+	return lb
+}
+
+// AddToAddress adds the addresses of the second indexed topic, i.e., the
+// recipient of Transfer and the spender of Approval.
+func (lb *LogFilterBody) AddToAddress(addresses ...string) *LogFilterBody {
+	lb.to = append(lb.to, addresses...)
+	return lb
+}
+
+// AddFromBlock adds the block tag or the hex-encoded number of the first block
+// of the range.
+func (lb *LogFilterBody) AddFromBlock(blockTag string) *LogFilterBody {
+	lb.fromBlock = blockTag
+	return lb
+}
+
+// AddToBlock adds the block tag or the hex-encoded number of the last block of
+// the range.
+func (lb *LogFilterBody) AddToBlock(blockTag string) *LogFilterBody {
+	lb.toBlock = blockTag
+	return lb
+}
+
+// Build validates its underlying instance and then creates the new instance
+// of LogFilter.
+func (lb *LogFilterBody) Build() (*LogFilter, error) {
+	for _, address := range lb.tokens {
+		if !utils.IsValidAddress(address) {
+			return nil, utils.ErrContractAddress
+		}
+	}
+
+	for _, addresses := range [][]string{lb.from, lb.to} {
+		for _, address := range addresses {
+			if !utils.IsValidAddress(address) {
+				return nil, utils.ErrToAddress
+			}
+		}
+	}
+
+	body := *lb
+	body.tokens = append([]string(nil), lb.tokens...)
+	body.from = append([]string(nil), lb.from...)
+	body.to = append([]string(nil), lb.to...)
+	return &LogFilter{LogFilterBody: body}, nil
+}
+
+// LogFilter represents the filter object of eth_getLogs.
+type LogFilter struct {
+	LogFilterBody
+}
+
+// GetFromBlock method returns the block tag of the first block of the range.
+func (lf *LogFilter) GetFromBlock() string {
+	return lf.fromBlock
+}
+
+// GetToBlock method returns the block tag of the last block of the range.
+func (lf *LogFilter) GetToBlock() string {
+	return lf.toBlock
+}
+
+// GetTopics method returns the topics of the filter: topic0 of the event
+// followed by the indexed addresses of each topic, where nil matches any
+// address. The trailing nil topics are left out.
+func (lf *LogFilter) GetTopics() [][]string {
+	topics := [][]string{{lf.topic}, addressTopics(lf.from), addressTopics(lf.to)}
+	for topics[len(topics)-1] == nil {
+		topics = topics[:len(topics)-1]
+	}
+	return topics
+}
+
+// addressTopics returns the given addresses as topics, i.e., left padded to
+// 32 bytes and hex-encoded with prefix 0x.
+func addressTopics(addresses []string) []string {
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	topics := make([]string, len(addresses))
+	for i, address := range addresses {
+		topics[i] = "0x" + strings.Repeat("0", 24) + strings.ToLower(address[2:])
+	}
+	return topics
+}
+
+// filterObject represents the filter object of eth_getLogs in JSON.
+type filterObject struct {
+	Address   []string   `json:"address,omitempty"`
+	Topics    [][]string `json:"topics"`
+	FromBlock string     `json:"fromBlock,omitempty"`
+	ToBlock   string     `json:"toBlock,omitempty"`
+}
+
+// MarshalJSON encodes the filter into the filter object of eth_getLogs.
+func (lf *LogFilter) MarshalJSON() ([]byte, error) {
+	return json.Marshal(filterObject{
+		Address:   lf.tokens,
+		Topics:    lf.GetTopics(),
+		FromBlock: lf.fromBlock,
+		ToBlock:   lf.toBlock,
+	})
+}
@@ -0,0 +1,138 @@
+package erc20
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+var (
+	senderTopic    string = "0x0000000000000000000000009d8a62f656a8d1615c1294fd71e9cfb3e4855a4f"
+	recipientTopic string = "0x00000000000000000000000027d22890587cfada7fec247c5180d73de6c670c4"
+	amountData     string = "0x0000000000000000000000000000000000000000000000000000000000000003"
+)
+
+func TestEventTopics(t *testing.T) {
+	if wanted := "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"; TransferTopic != wanted {
+		t.Errorf("got %v, wanted %v", TransferTopic, wanted)
+	}
+	if wanted := "0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925"; ApprovalTopic != wanted {
+		t.Errorf("got %v, wanted %v", ApprovalTopic, wanted)
+	}
+}
+
+func TestDecodeTransferLog(t *testing.T) {
+	var log Log
+	err := json.Unmarshal([]byte(`{
+		"address": "0xdac17f958d2ee523a2206206994597c13d831ec7",
+		"topics": ["`+TransferTopic+`", "`+senderTopic+`", "`+recipientTopic+`"],
+		"data": "`+amountData+`",
+		"blockNumber": "0x1158dbe",
+		"logIndex": "0x0"
+	}`), &log)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	event, err := DecodeTransferLog(&log)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wanted := TransferEvent{
+		Token: "0xdAC17F958D2ee523a2206206994597C13D831ec7",
+		From:  "0x9d8A62f656a8d1615C1294fd71e9CFb3E4855A4F",
+		To:    "0x27D22890587cfaDA7fec247C5180D73dE6C670c4",
+	}
+	if event.Token != wanted.Token || event.From != wanted.From || event.To != wanted.To {
+		t.Errorf("got %v, wanted %v", event, wanted)
+	}
+	if event.Amount.String() != "3" {
+		t.Errorf("got %v, wanted %v", event.Amount, 3)
+	}
+
+	if _, err := DecodeApprovalLog(&log); !errors.Is(err, utils.ErrEventLog) {
+		t.Errorf("got %v, wanted %v", err, utils.ErrEventLog)
+	}
+}
+
+func TestDecodeApprovalLog(t *testing.T) {
+	log := &Log{
+		Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+		Topics:  []string{ApprovalTopic, senderTopic, recipientTopic},
+		Data:    "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+	}
+
+	event, err := DecodeApprovalLog(log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event.Owner != "0x9d8A62f656a8d1615C1294fd71e9CFb3E4855A4F" || event.Spender != "0x27D22890587cfaDA7fec247C5180D73dE6C670c4" {
+		t.Errorf("got %v %v, wanted the owner and the spender", event.Owner, event.Spender)
+	}
+	if event.Amount.BitLen() != 256 {
+		t.Errorf("got %v, wanted %v", event.Amount.BitLen(), 256)
+	}
+}
+
+func TestDecodeTransferLogInvalid(t *testing.T) {
+	token := "0xdac17f958d2ee523a2206206994597c13d831ec7"
+	logs := []*Log{
+		nil,
+		// ERC-721 Transfer indexes the token id as well.
+		{Address: token, Topics: []string{TransferTopic, senderTopic, recipientTopic, amountData}, Data: "0x"},
+		{Address: token, Topics: []string{TransferTopic, senderTopic}, Data: amountData},
+		{Address: "0xdac17f", Topics: []string{TransferTopic, senderTopic, recipientTopic}, Data: amountData},
+		{Address: token, Topics: []string{TransferTopic, "0x01" + senderTopic[4:], recipientTopic}, Data: amountData},
+		{Address: token, Topics: []string{TransferTopic, senderTopic, recipientTopic[:64]}, Data: amountData},
+		{Address: token, Topics: []string{TransferTopic, senderTopic, recipientTopic}, Data: "0x"},
+		{Address: token, Topics: []string{TransferTopic, senderTopic, recipientTopic}, Data: amountData + "00"},
+	}
+
+	for _, log := range logs {
+		if _, err := DecodeTransferLog(log); !errors.Is(err, utils.ErrEventLog) {
+			t.Errorf("got %v, wanted %v", err, utils.ErrEventLog)
+		}
+	}
+}
+
+func TestLogFilter(t *testing.T) {
+	filter, err := NewTransferFilter().
+		AddTokenAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7").
+		AddToAddress("0x27D22890587cfaDA7fec247C5180D73dE6C670c4").
+		AddFromBlock("0x1158dbe").
+		AddToBlock("latest").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, _ := json.Marshal(filter)
+	wanted := `{"address":["0xdAC17F958D2ee523a2206206994597C13D831ec7"],"topics":[["` + TransferTopic + `"],null,["` +
+		recipientTopic + `"]],"fromBlock":"0x1158dbe","toBlock":"latest"}`
+	if string(got) != wanted {
+		t.Errorf("got %v, wanted %v", string(got), wanted)
+	}
+
+	filter, err = NewApprovalFilter().AddFromAddress("0x9d8A62f656a8d1615C1294fd71e9CFb3E4855A4F").Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, _ = json.Marshal(filter)
+	wanted = `{"topics":[["` + ApprovalTopic + `"],["` + senderTopic + `"]]}`
+	if string(got) != wanted {
+		t.Errorf("got %v, wanted %v", string(got), wanted)
+	}
+}
+
+func TestLogFilterInvalid(t *testing.T) {
+	if _, err := NewTransferFilter().AddTokenAddress("0xdac17f").Build(); err != utils.ErrContractAddress {
+		t.Errorf("got %v, wanted %v", err, utils.ErrContractAddress)
+	}
+	if _, err := NewTransferFilter().AddFromAddress("0x9d8a62f656a8d1615c1294fd71e9cfb3e4855a4").Build(); err != utils.ErrToAddress {
+		t.Errorf("got %v, wanted %v", err, utils.ErrToAddress)
+	}
+}
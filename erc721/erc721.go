@@ -0,0 +1,251 @@
+package erc721
+
+import (
+	"math/big"
+
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+// ERC721Body holds the necessary transfer information to be used by a
+// transaction that will interact with the ERC721-based token standard.
+type ERC721Body struct {
+	to, tokenID, data string
+	tokenAddress      string
+	approved          bool
+	extraData         []byte
+}
+
+// New creates and returns an empty instance of ERC721Body.
+func New() *ERC721Body {
+	return &ERC721Body{}
+}
+
+// AddToAddress method adds the recipient address to its instance. The same
+// address is used as the operator for setApprovalForAll and isApprovedForAll.
+func (eb *ERC721Body) AddToAddress(to string) *ERC721Body {
+	eb.to = to
+	return eb
+}
+
+// AddTokenID method adds the identifier of the non-fungible token to its
+// instance.
+func (eb *ERC721Body) AddTokenID(tokenID string) *ERC721Body {
+	eb.tokenID = tokenID
+	return eb
+}
+
+// AddTokenAddress adds the contract address of the ERC721-based standard
+// token.
+func (eb *ERC721Body) AddTokenAddress(tokenAddr string) *ERC721Body {
+	eb.tokenAddress = tokenAddr
+	return eb
+}
+
+// AddData adds an account address. Later on, this address will use as a
+// parameter for ERC721-based token methods: transferFrom, safeTransferFrom
+// (from) and isApprovedForAll (owner).
+func (eb *ERC721Body) AddData(data string) *ERC721Body {
+	eb.data = data
+	return eb
+}
+
+// AddApproval adds the approval flag to be used by setApprovalForAll.
+func (eb *ERC721Body) AddApproval(approved bool) *ERC721Body {
+	eb.approved = approved
+	return eb
+}
+
+// AddExtraData adds the arbitrary bytes passed along by safeTransferFrom
+// to the receiving contract.
+func (eb *ERC721Body) AddExtraData(extraData []byte) *ERC721Body {
+	eb.extraData = extraData
+	return eb
+}
+
+// Build validates its underlying instance and then creates the
+// new instance of ERC721Clause. It returns utils.ValidationErrors of all the
+// failing fields.
+func (b *ERC721Body) Build() (*ERC721Clause, error) {
+	var errs utils.ValidationErrors
+	if !utils.IsValidAddress(b.tokenAddress) {
+		errs = append(errs, utils.NewValidationError(utils.FieldTokenAddress, b.tokenAddress, utils.ErrTokenAddress))
+	} else if utils.EqualAddresses(b.tokenAddress, b.to) {
+		errs = append(errs, utils.NewValidationError(utils.FieldTo, b.to, utils.ErrSameEOAContractAddr))
+	}
+
+	if !utils.IsValidDecimalValue(b.tokenID) {
+		errs = append(errs, utils.NewValidationError(utils.FieldTokenID, b.tokenID, utils.ErrTokenID))
+	} else if _, err := utils.ParseUint256(b.tokenID); err != nil {
+		errs = append(errs, utils.NewValidationError(utils.FieldTokenID, b.tokenID, err))
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return &ERC721Clause{ERC721Body: *b}, nil
+}
+
+// ERC721Clause represents the transfer information for the ERC-721 standard used by
+// a transaction within an ethereum or ethereum-based fork.
+type ERC721Clause struct {
+	ERC721Body
+}
+
+// GetTokenAddress returns the contract address of the ERC-721 standard token.
+func (erc *ERC721Clause) GetTokenAddress() string {
+	return erc.tokenAddress
+}
+
+// GetToAddress returns the receiver address for the ERC-721 standard token.
+func (erc *ERC721Clause) GetToAddress() string {
+	return erc.to
+}
+
+// GetTokenID returns the identifier of the ERC-721 standard token.
+func (erc *ERC721Clause) GetTokenID() string {
+	return erc.tokenID
+}
+
+// TokenBalance returns the payload of token balance for the ERC-721-based getter.
+func (erc *ERC721Clause) TokenBalance() ([]byte, error) {
+	return erc.addressPayload(balance, erc.to)
+}
+
+// TokenOwnerOf returns the payload to find the owner of the token for the
+// ERC-721-based getter.
+func (erc *ERC721Clause) TokenOwnerOf() ([]byte, error) {
+	return erc.payload(ownerOf)
+}
+
+// TokenGetApproved returns the payload to find the approved address of the token
+// for the ERC-721-based getter.
+func (erc *ERC721Clause) TokenGetApproved() ([]byte, error) {
+	return erc.payload(getApproved)
+}
+
+// TokenURI returns the payload of the token metadata URI for the ERC-721-based getter.
+func (erc *ERC721Clause) TokenURI() ([]byte, error) {
+	return erc.payload(tokenURI)
+}
+
+// TokenApprove returns the payload of the token to approve for the ERC-721-based method.
+func (erc *ERC721Clause) TokenApprove() ([]byte, error) {
+	return erc.payload(approve, erc.to)
+}
+
+// TokenSetApprovalForAll returns the payload to enable or disable the approval of an
+// operator to manage all tokens of the caller for the ERC-721-based method.
+func (erc *ERC721Clause) TokenSetApprovalForAll(approved bool) ([]byte, error) {
+	payload, err := erc.addressPayload(setApprovalForAll, erc.to)
+	if err != nil {
+		return nil, err
+	}
+
+	flag := make([]byte, 32)
+	if approved {
+		flag[31] = 1
+	}
+	return append(payload, flag...), nil
+}
+
+// TokenIsApprovedForAll returns the payload to find whether an operator is allowed to
+// manage all tokens of the owner for the ERC-721-based getter.
+func (erc *ERC721Clause) TokenIsApprovedForAll(owner string) ([]byte, error) {
+	return erc.addressPayload(isApprovedForAll, owner, erc.to)
+}
+
+// TokenTransferFrom returns the payload of "token transfer from the owner address to
+// the recipient address" for the ERC-721-based method.
+func (erc *ERC721Clause) TokenTransferFrom(from string) ([]byte, error) {
+	return erc.payload(transferFrom, from, erc.to)
+}
+
+// TokenSafeTransferFrom returns the payload of the safe token transfer for the
+// ERC-721-based method.
+func (erc *ERC721Clause) TokenSafeTransferFrom(from string) ([]byte, error) {
+	return erc.payload(safeTransferFrom, from, erc.to)
+}
+
+// TokenSafeTransferFromWithData returns the payload of the safe token transfer for the
+// ERC-721-based method along with the bytes data passed to the receiving contract.
+func (erc *ERC721Clause) TokenSafeTransferFromWithData(from string, data []byte) ([]byte, error) {
+	payload, err := erc.payload(safeTransferFromWithData, from, erc.to)
+	if err != nil {
+		return nil, err
+	}
+
+	// the dynamic bytes are placed after the four static head values.
+	offset := utils.LeftPadBytes(big.NewInt(4*32).Bytes(), 32)
+	length := utils.LeftPadBytes(big.NewInt(int64(len(data))).Bytes(), 32)
+	paddedLength := (len(data) + 31) / 32 * 32
+
+	payload = append(payload, offset...)
+	payload = append(payload, length...)
+	payload = append(payload, utils.RightPadBytes(data, paddedLength)...)
+	return payload, nil
+}
+
+// payload creates the data array of the given method using the given addresses
+// followed by the token ID.
+func (erc *ERC721Clause) payload(method string, addresses ...string) ([]byte, error) {
+	data, err := erc.addressPayload(method, addresses...)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenID, err := utils.ParseUint256(erc.tokenID)
+	if err == utils.ErrValue {
+		return nil, utils.ErrTokenID
+	} else if err != nil {
+		return nil, err
+	}
+	return append(data, tokenID.Word()...), nil
+}
+
+// addressPayload creates the data array of the given method using the given
+// addresses only.
+func (erc *ERC721Clause) addressPayload(method string, addresses ...string) ([]byte, error) {
+	methodID := erc721methodIDs[method]
+
+	var data []byte
+	data = append(data, methodID[:]...)
+	for _, addr := range addresses {
+		address, err := utils.AddresstoBytes(addr)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, utils.LeftPadBytes(address, 32)...)
+	}
+	return data, nil
+}
+
+// GetERCPayloadData returns the payload of the given method in a byte array. Moreover, purposely,
+// it can be called from outside this package or through the interface. The safeTransferFrom
+// method uses its bytes overload whenever extra data has been added.
+func (erc *ERC721Clause) GetERCPayloadData(method string) ([]byte, error) {
+	switch method {
+	case "balanceOf":
+		return erc.TokenBalance()
+	case "ownerOf":
+		return erc.TokenOwnerOf()
+	case "getApproved":
+		return erc.TokenGetApproved()
+	case "tokenURI":
+		return erc.TokenURI()
+	case "approve":
+		return erc.TokenApprove()
+	case "setApprovalForAll":
+		return erc.TokenSetApprovalForAll(erc.approved)
+	case "isApprovedForAll":
+		return erc.TokenIsApprovedForAll(erc.data)
+	case "transferFrom":
+		return erc.TokenTransferFrom(erc.data)
+	case "safeTransferFrom":
+		if erc.extraData != nil {
+			return erc.TokenSafeTransferFromWithData(erc.data, erc.extraData)
+		}
+		return erc.TokenSafeTransferFrom(erc.data)
+	default:
+		return nil, utils.NewValidationError(utils.FieldMethod, method, utils.ErrMethod)
+	}
+}
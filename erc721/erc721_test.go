@@ -0,0 +1,167 @@
+package erc721
+
+import (
+	"encoding/hex"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/mirzazhar/golang-transfer-clause/clause"
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+var (
+	address         string = "0x27d22890587cfada7fec247c5180d73de6c670c4"
+	contractaddress string = "0xf6fe970533fe5C63d196139B14522Eb2956f8621"
+	fromaddress     string = "0x0bf4A8E0D09C3B16Bb6B90362Bc4218589b0a567"
+)
+
+const (
+	paddedaddress     = "00000000000000000000000027d22890587cfada7fec247c5180d73de6c670c4"
+	paddedfromaddress = "0000000000000000000000000bf4a8e0d09c3b16bb6b90362bc4218589b0a567"
+	paddedtokenid     = "000000000000000000000000000000000000000000000000000000000000002a"
+)
+
+func createERC721Clause() (*ERC721Clause, error) {
+	erc721clause, err := New().
+		AddToAddress(address).
+		AddTokenID("42").
+		AddTokenAddress(contractaddress).
+		AddData(fromaddress).
+		Build()
+	return erc721clause, err
+}
+
+func TestCreateClause(t *testing.T) {
+	erc721clause, err := createERC721Clause()
+	if err != nil {
+		t.Errorf("cannot create erc721clause: %v", err)
+	}
+
+	expectederc721clause := &ERC721Clause{
+		ERC721Body{
+			to:           address,
+			tokenID:      "42",
+			data:         fromaddress,
+			tokenAddress: contractaddress,
+		},
+	}
+
+	if !reflect.DeepEqual(erc721clause, expectederc721clause) {
+		t.Errorf("got %v, wanted %v", erc721clause, expectederc721clause)
+	}
+}
+
+func TestCreateClauseInvalid(t *testing.T) {
+	_, err := New().AddToAddress(address).AddTokenID("4.2").AddTokenAddress(contractaddress).Build()
+	if err == nil {
+		t.Errorf("got %v, wanted an error for an invalid token id", err)
+	}
+
+	_, err = New().AddToAddress(address).AddTokenID("42").Build()
+	if err == nil {
+		t.Errorf("got %v, wanted an error for an invalid token address", err)
+	}
+
+	// 2^256 does not fit in the uint256 token id.
+	tokenID := "115792089237316195423570985008687907853269984665640564039457584007913129639936"
+	_, err = New().AddToAddress(address).AddTokenID(tokenID).AddTokenAddress(contractaddress).Build()
+	if !errors.Is(err, utils.ErrValueOutOfRange) {
+		t.Errorf("got %v, wanted %v", err, utils.ErrValueOutOfRange)
+	}
+
+	_, err = New().AddToAddress(address).AddTokenID("4.2").AddTokenAddress("0xf6fe97").Build()
+	var errs utils.ValidationErrors
+	if !errors.As(err, &errs) || errs.Field(utils.FieldTokenAddress) == nil || errs.Field(utils.FieldTokenID) == nil {
+		t.Errorf("got %v, wanted the errors of %v and %v", err, utils.FieldTokenAddress, utils.FieldTokenID)
+	}
+}
+
+func TestPayloads(t *testing.T) {
+	erc721clause, err := createERC721Clause()
+	if err != nil {
+		t.Errorf("cannot create erc721clause: %v", err)
+	}
+
+	expectedpayloads := map[string]string{
+		"balanceOf":         "70a08231" + paddedaddress,
+		"ownerOf":           "6352211e" + paddedtokenid,
+		"getApproved":       "081812fc" + paddedtokenid,
+		"tokenURI":          "c87b56dd" + paddedtokenid,
+		"approve":           "095ea7b3" + paddedaddress + paddedtokenid,
+		"isApprovedForAll":  "e985e9c5" + paddedfromaddress + paddedaddress,
+		"transferFrom":      "23b872dd" + paddedfromaddress + paddedaddress + paddedtokenid,
+		"safeTransferFrom":  "42842e0e" + paddedfromaddress + paddedaddress + paddedtokenid,
+		"setApprovalForAll": "a22cb465" + paddedaddress + "0000000000000000000000000000000000000000000000000000000000000000",
+	}
+
+	for method, expected := range expectedpayloads {
+		payloaddata, err := erc721clause.GetERCPayloadData(method)
+		if err != nil {
+			t.Errorf("cannot create payload data for %s: %v", method, err)
+		}
+
+		hexvaluepayload := hex.EncodeToString(payloaddata)
+		if hexvaluepayload != expected {
+			t.Errorf("%s: got %v, wanted %v", method, hexvaluepayload, expected)
+		}
+	}
+}
+
+func TestSetApprovalForAll(t *testing.T) {
+	erc721clause, err := createERC721Clause()
+	if err != nil {
+		t.Errorf("cannot create erc721clause: %v", err)
+	}
+
+	erc721clause.AddApproval(true)
+	payloaddata, err := erc721clause.GetERCPayloadData("setApprovalForAll")
+	if err != nil {
+		t.Errorf("cannot create payload data for setApprovalForAll: %v", err)
+	}
+
+	hexvaluepayload := hex.EncodeToString(payloaddata)
+	expected := "a22cb465" + paddedaddress + "0000000000000000000000000000000000000000000000000000000000000001"
+	if hexvaluepayload != expected {
+		t.Errorf("got %v, wanted %v", hexvaluepayload, expected)
+	}
+}
+
+func TestSafeTransferFromWithData(t *testing.T) {
+	erc721clause, err := createERC721Clause()
+	if err != nil {
+		t.Errorf("cannot create erc721clause: %v", err)
+	}
+
+	erc721clause.AddExtraData([]byte{0xca, 0xfe})
+	payloaddata, err := erc721clause.GetERCPayloadData("safeTransferFrom")
+	if err != nil {
+		t.Errorf("cannot create payload data for safeTransferFrom: %v", err)
+	}
+
+	hexvaluepayload := hex.EncodeToString(payloaddata)
+	expected := "b88d4fde" + paddedfromaddress + paddedaddress + paddedtokenid +
+		"0000000000000000000000000000000000000000000000000000000000000080" +
+		"0000000000000000000000000000000000000000000000000000000000000002" +
+		"cafe000000000000000000000000000000000000000000000000000000000000"
+	if hexvaluepayload != expected {
+		t.Errorf("got %v, wanted %v", hexvaluepayload, expected)
+	}
+}
+
+func TestNewClause(t *testing.T) {
+	erc721clause, err := createERC721Clause()
+	if err != nil {
+		t.Errorf("cannot create erc721clause: %v", err)
+	}
+
+	cl, err := clause.NewClause(erc721clause, "transferFrom")
+	if err != nil {
+		t.Errorf("cannot create clause: %v", err)
+	}
+
+	expected := "23b872dd" + paddedfromaddress + paddedaddress + paddedtokenid
+	if cl.GetToAddress() != contractaddress || cl.GetData() != expected {
+		t.Errorf("got %v, wanted %v", cl.GetData(), expected)
+	}
+}
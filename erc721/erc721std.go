@@ -0,0 +1,40 @@
+package erc721
+
+import (
+	"github.com/mirzazhar/golang-transfer-clause/selector"
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+// ERC721-based token standard; getters and functions.
+var (
+	balance                  string = "balanceOf(address)"
+	ownerOf                  string = "ownerOf(uint256)"
+	getApproved              string = "getApproved(uint256)"
+	tokenURI                 string = "tokenURI(uint256)"
+	approve                  string = "approve(address,uint256)"
+	setApprovalForAll        string = "setApprovalForAll(address,bool)"
+	isApprovedForAll         string = "isApprovedForAll(address,address)"
+	transferFrom             string = "transferFrom(address,address,uint256)"
+	safeTransferFrom         string = "safeTransferFrom(address,address,uint256)"
+	safeTransferFromWithData string = "safeTransferFrom(address,address,uint256,bytes)"
+)
+
+// erc721methodIDs holds the method ID of the ERC721-based token standard;
+// its signatures are registered in selector.Default as well.
+var erc721methodIDs = make(map[string][4]byte)
+
+func init() {
+	erc721standard := []string{balance, ownerOf, getApproved, tokenURI, approve,
+		setApprovalForAll, isApprovedForAll, transferFrom, safeTransferFrom,
+		safeTransferFromWithData}
+
+	for _, method := range erc721standard {
+		erc721methodIDs[method] = methodID(method)
+		selector.Default.Register(method)
+	}
+}
+
+// methodID calculates and returns the method ID of 4 bytes.
+func methodID(method string) [4]byte {
+	return utils.MethodID(method)
+}
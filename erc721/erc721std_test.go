@@ -0,0 +1,29 @@
+package erc721
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestMethodID(t *testing.T) {
+	methodIDs := make(map[string]string)
+	methodIDs[balance] = "70a08231"
+	methodIDs[ownerOf] = "6352211e"
+	methodIDs[getApproved] = "081812fc"
+	methodIDs[tokenURI] = "c87b56dd"
+	methodIDs[approve] = "095ea7b3"
+	methodIDs[setApprovalForAll] = "a22cb465"
+	methodIDs[isApprovedForAll] = "e985e9c5"
+	methodIDs[transferFrom] = "23b872dd"
+	methodIDs[safeTransferFrom] = "42842e0e"
+	methodIDs[safeTransferFromWithData] = "b88d4fde"
+
+	for method, expectedmethodID := range methodIDs {
+		id := methodID(method)
+		methodId := hex.EncodeToString(id[:])
+
+		if methodId != expectedmethodID {
+			t.Errorf("got %v, wanted %v", methodId, expectedmethodID)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package gas
+
+import (
+	"github.com/mirzazhar/golang-transfer-clause/clause"
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+// Schedule holds the intrinsic gas costs of a chain, i.e., the gas charged
+// before any code is executed. Forks are modelled by separate schedules.
+type Schedule struct {
+	// TxGas is charged once per transaction.
+	TxGas uint64
+
+	// ClauseGas is charged per clause that calls or transfers to an address.
+	ClauseGas uint64
+
+	// ContractCreationClauseGas is charged per clause that deploys a contract,
+	// instead of ClauseGas.
+	ContractCreationClauseGas uint64
+
+	// TxDataZeroGas and TxDataNonZeroGas are charged per zero and non-zero
+	// byte of the data of every clause.
+	TxDataZeroGas    uint64
+	TxDataNonZeroGas uint64
+
+	// InitCodeWordGas is charged per word of 32 bytes of the init code of
+	// every contract creation clause.
+	InitCodeWordGas uint64
+
+	// MaxClauses limits the number of clauses of a transaction; zero means
+	// no limit.
+	MaxClauses int
+}
+
+// Intrinsic gas schedules of the ethereum forks and VeChainThor.
+var (
+	// EthereumFrontier charges the same for the contract creation as any
+	// other transaction.
+	EthereumFrontier = Schedule{
+		TxGas:            21000,
+		TxDataZeroGas:    4,
+		TxDataNonZeroGas: 68,
+		MaxClauses:       1,
+	}
+
+	// EthereumHomestead adds the surcharge of the contract creation.
+	EthereumHomestead = Schedule{
+		TxGas:                     21000,
+		ContractCreationClauseGas: 32000,
+		TxDataZeroGas:             4,
+		TxDataNonZeroGas:          68,
+		MaxClauses:                1,
+	}
+
+	// EthereumIstanbul reduces the cost of the non-zero data bytes (EIP-2028).
+	EthereumIstanbul = Schedule{
+		TxGas:                     21000,
+		ContractCreationClauseGas: 32000,
+		TxDataZeroGas:             4,
+		TxDataNonZeroGas:          16,
+		MaxClauses:                1,
+	}
+
+	// EthereumShanghai charges the words of the init code (EIP-3860).
+	EthereumShanghai = Schedule{
+		TxGas:                     21000,
+		ContractCreationClauseGas: 32000,
+		TxDataZeroGas:             4,
+		TxDataNonZeroGas:          16,
+		InitCodeWordGas:           2,
+		MaxClauses:                1,
+	}
+
+	// Ethereum is the schedule of the latest ethereum fork.
+	Ethereum = EthereumShanghai
+
+	// VeChain is the schedule of VeChainThor, whose transaction carries
+	// several clauses.
+	VeChain = Schedule{
+		TxGas:                     5000,
+		ClauseGas:                 16000,
+		ContractCreationClauseGas: 48000,
+		TxDataZeroGas:             4,
+		TxDataNonZeroGas:          68,
+	}
+)
+
+// IntrinsicGas calculates the intrinsic gas of the transaction of the given
+// clauses according to the given schedule. Like VeChainThor, the transaction
+// without any clause is charged as if it had a single clause without data.
+func IntrinsicGas(schedule Schedule, clauses ...*clause.Clause) (uint64, error) {
+	if schedule.MaxClauses > 0 && len(clauses) > schedule.MaxClauses {
+		return 0, utils.ErrClauseCount
+	} else if len(clauses) == 0 {
+		return schedule.TxGas + schedule.ClauseGas, nil
+	}
+
+	gas := schedule.TxGas
+	for _, cl := range clauses {
+		if cl == nil {
+			return 0, utils.ErrClause
+		}
+
+		data, err := cl.GetDataBytes()
+		if err != nil {
+			return 0, err
+		}
+
+		if cl.IsContractCreation() {
+			gas += schedule.ContractCreationClauseGas
+			gas += uint64(len(data)+31) / 32 * schedule.InitCodeWordGas
+		} else {
+			gas += schedule.ClauseGas
+		}
+		gas += dataGas(schedule, data)
+	}
+	return gas, nil
+}
+
+// dataGas calculates the gas of the given data.
+func dataGas(schedule Schedule, data []byte) uint64 {
+	var gas uint64
+	for _, b := range data {
+		if b == 0 {
+			gas += schedule.TxDataZeroGas
+		} else {
+			gas += schedule.TxDataNonZeroGas
+		}
+	}
+	return gas
+}
@@ -0,0 +1,95 @@
+package gas
+
+import (
+	"testing"
+
+	"github.com/mirzazhar/golang-transfer-clause/clause"
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+var address string = "0x7567d83b7b8d80addcb281a71d54fc7b3364ffed"
+
+// usdtTransfer is the calldata of a USDT transfer; 41 zero and 27 non-zero bytes.
+var usdtTransfer string = "0xa9059cbb000000000000000000000000cf3aa1a77fa8c221f80bd15f4d7a36186eeb7df10000000000000000000000000000000000000000000000000000000007270e00"
+
+func TestIntrinsicGasEthereum(t *testing.T) {
+	transfer, _ := clause.New().AddToAddress(address).AddValue("1").Build()
+	call, _ := clause.New().AddToAddress(address).AddValue("0").AddData(usdtTransfer).Build()
+	creation, _ := clause.New().AddValue("0").AddData("0x6080604052").BuildContractCreation()
+
+	tests := []struct {
+		schedule Schedule
+		clause   *clause.Clause
+		expected uint64
+	}{
+		{Ethereum, transfer, 21000},
+		{Ethereum, call, 21596},
+		{EthereumHomestead, call, 23000},
+		{EthereumShanghai, creation, 53082},
+		{EthereumIstanbul, creation, 53080},
+		{EthereumHomestead, creation, 53340},
+		{EthereumFrontier, creation, 21340},
+	}
+
+	for _, test := range tests {
+		gas, err := IntrinsicGas(test.schedule, test.clause)
+		if err != nil {
+			t.Errorf("cannot calculate intrinsic gas: %v", err)
+		}
+
+		if gas != test.expected {
+			t.Errorf("got %v, wanted %v", gas, test.expected)
+		}
+	}
+
+	if _, err := IntrinsicGas(Ethereum, transfer, call); err != utils.ErrClauseCount {
+		t.Errorf("got %v, wanted %v", err, utils.ErrClauseCount)
+	}
+}
+
+func TestIntrinsicGasVeChain(t *testing.T) {
+	// the clauses of the VeChainThor transaction test.
+	first, _ := clause.New().AddToAddress(address).AddValue("10000").AddUnit(clause.Wei).AddData("0x000000606060").Build()
+	second, _ := clause.New().AddToAddress(address).AddValue("20000").AddUnit(clause.Wei).AddData("0x000000606060").Build()
+	creation, _ := clause.New().AddValue("0").AddData("0x6080604052").BuildContractCreation()
+
+	tests := []struct {
+		clauses  []*clause.Clause
+		expected uint64
+	}{
+		{nil, 21000},
+		{[]*clause.Clause{first, second}, 37432},
+		{[]*clause.Clause{first, creation}, 5000 + 16000 + 216 + 48000 + 340},
+	}
+
+	for _, test := range tests {
+		gas, err := IntrinsicGas(VeChain, test.clauses...)
+		if err != nil {
+			t.Errorf("cannot calculate intrinsic gas: %v", err)
+		}
+
+		if gas != test.expected {
+			t.Errorf("got %v, wanted %v", gas, test.expected)
+		}
+	}
+
+	if _, err := IntrinsicGas(VeChain, first, nil); err != utils.ErrClause {
+		t.Errorf("got %v, wanted %v", err, utils.ErrClause)
+	}
+}
+
+func TestIntrinsicGasCustomSchedule(t *testing.T) {
+	// a fork that charges every data byte the same.
+	schedule := Ethereum
+	schedule.TxDataZeroGas = 16
+
+	call, _ := clause.New().AddToAddress(address).AddValue("0").AddData(usdtTransfer).Build()
+	gas, err := IntrinsicGas(schedule, call)
+	if err != nil {
+		t.Errorf("cannot calculate intrinsic gas: %v", err)
+	}
+
+	if gas != 21000+68*16 {
+		t.Errorf("got %v, wanted %v", gas, 21000+68*16)
+	}
+}
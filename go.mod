@@ -2,6 +2,10 @@ module github.com/mirzazhar/golang-transfer-clause
 
 go 1.18
 
-require golang.org/x/crypto v0.0.0-20220722155217-630584e8d5aa
+require (
+	github.com/decred/dcrd/dcrec/secp256k1/v4 v4.3.0
+	github.com/skip2/go-qrcode v0.0.0-20200617195104-da1b6568686e
+	golang.org/x/crypto v0.0.0-20220722155217-630584e8d5aa
+)
 
 require golang.org/x/sys v0.0.0-20210615035016-665e8c7367d1 // indirect
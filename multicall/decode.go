@@ -0,0 +1,96 @@
+package multicall
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+// Result holds the result of a single call of the bundle.
+type Result struct {
+	Success    bool
+	ReturnData []byte
+}
+
+// DecodeAggregate3 decodes the return data of aggregate3, i.e., the ABI
+// encoding of (bool success, bytes returnData)[], into the results.
+func DecodeAggregate3(data []byte) ([]Result, error) {
+	offset, err := readOffset(data, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	length, err := readOffset(data, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	// the heads of the elements hold their offsets relative to the first head.
+	start := offset + 32
+	if length > (len(data)-start)/32 {
+		return nil, fmt.Errorf("%w: %d results exceed the return data", utils.ErrReturnDataLength, length)
+	}
+
+	results := make([]Result, length)
+	for i := range results {
+		elementOffset, err := readOffset(data, start+i*32)
+		if err != nil {
+			return nil, err
+		}
+
+		if results[i], err = decodeResult(data, start+elementOffset); err != nil {
+			return nil, err
+		}
+	}
+	return results, nil
+}
+
+// decodeResult decodes the tuple (bool, bytes) at the given position.
+func decodeResult(data []byte, position int) (Result, error) {
+	success, err := readWord(data, position)
+	if err != nil {
+		return Result{}, err
+	} else if success.Cmp(big.NewInt(1)) > 0 {
+		return Result{}, fmt.Errorf("%w: invalid bool %s", utils.ErrReturnDataFormat, success)
+	}
+
+	offset, err := readOffset(data, position+32)
+	if err != nil {
+		return Result{}, err
+	}
+
+	length, err := readOffset(data, position+offset)
+	if err != nil {
+		return Result{}, err
+	}
+
+	start := position + offset + 32
+	if length > len(data)-start {
+		return Result{}, fmt.Errorf("%w: return data of %d bytes is truncated", utils.ErrReturnDataLength, length)
+	}
+
+	returnData := make([]byte, length)
+	copy(returnData, data[start:start+length])
+	return Result{Success: success.Sign() == 1, ReturnData: returnData}, nil
+}
+
+// readWord reads the word of 32 bytes at the given position as an integer.
+func readWord(data []byte, position int) (*big.Int, error) {
+	if position < 0 || position > len(data)-32 {
+		return nil, fmt.Errorf("%w: word at %d is out of bounds", utils.ErrReturnDataLength, position)
+	}
+	return new(big.Int).SetBytes(data[position : position+32]), nil
+}
+
+// readOffset reads the word at the given position as an offset or a length,
+// which must not exceed the return data.
+func readOffset(data []byte, position int) (int, error) {
+	word, err := readWord(data, position)
+	if err != nil {
+		return 0, err
+	} else if !word.IsInt64() || word.Int64() > int64(len(data)) {
+		return 0, fmt.Errorf("%w: offset %s exceeds the return data", utils.ErrReturnDataFormat, word)
+	}
+	return int(word.Int64()), nil
+}
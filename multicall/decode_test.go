@@ -0,0 +1,73 @@
+package multicall
+
+import (
+	"encoding/hex"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+// aggregate3Result is the return data of a successful call returning 120000000
+// followed by a failed call without return data.
+var aggregate3Result string = "0000000000000000000000000000000000000000000000000000000000000020" +
+	"0000000000000000000000000000000000000000000000000000000000000002" +
+	"0000000000000000000000000000000000000000000000000000000000000040" +
+	"00000000000000000000000000000000000000000000000000000000000000c0" +
+	"0000000000000000000000000000000000000000000000000000000000000001" +
+	"0000000000000000000000000000000000000000000000000000000000000040" +
+	"0000000000000000000000000000000000000000000000000000000000000020" +
+	"0000000000000000000000000000000000000000000000000000000007270e00" +
+	"0000000000000000000000000000000000000000000000000000000000000000" +
+	"0000000000000000000000000000000000000000000000000000000000000040" +
+	"0000000000000000000000000000000000000000000000000000000000000000"
+
+func TestDecodeAggregate3(t *testing.T) {
+	data, _ := hex.DecodeString(aggregate3Result)
+	results, err := DecodeAggregate3(data)
+	if err != nil {
+		t.Errorf("cannot decode results: %v", err)
+	}
+
+	returnData, _ := hex.DecodeString("0000000000000000000000000000000000000000000000000000000007270e00")
+	expected := []Result{{Success: true, ReturnData: returnData}, {Success: false, ReturnData: []byte{}}}
+	if !reflect.DeepEqual(results, expected) {
+		t.Errorf("got %v, wanted %v", results, expected)
+	}
+
+	multicall, err := createMulticall()
+	if err != nil {
+		t.Errorf("cannot create multicall: %v", err)
+	}
+
+	if _, err := multicall.Decode(data); err != nil {
+		t.Errorf("cannot decode results: %v", err)
+	}
+
+	// the results of a single call do not match the bundle of two calls.
+	single, _ := hex.DecodeString(aggregate3Result[:64] + "0000000000000000000000000000000000000000000000000000000000000001" + aggregate3Result[128:])
+	if _, err := multicall.Decode(single); !errors.Is(err, utils.ErrReturnDataFormat) {
+		t.Errorf("got %v, wanted %v", err, utils.ErrReturnDataFormat)
+	}
+}
+
+func TestDecodeAggregate3Invalid(t *testing.T) {
+	tests := []struct {
+		data string
+		err  error
+	}{
+		{"", utils.ErrReturnDataLength},
+		{aggregate3Result[:len(aggregate3Result)-64], utils.ErrReturnDataLength},
+		{"0000000000000000000000000000000000000000000000000000000000000400", utils.ErrReturnDataFormat},
+		// the success of the first result is neither true nor false.
+		{aggregate3Result[:256] + "0000000000000000000000000000000000000000000000000000000000000002" + aggregate3Result[320:], utils.ErrReturnDataFormat},
+	}
+
+	for _, test := range tests {
+		data, _ := hex.DecodeString(test.data)
+		if _, err := DecodeAggregate3(data); !errors.Is(err, test.err) {
+			t.Errorf("got %v, wanted %v", err, test.err)
+		}
+	}
+}
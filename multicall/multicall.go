@@ -0,0 +1,134 @@
+package multicall
+
+import (
+	"fmt"
+
+	"github.com/mirzazhar/golang-transfer-clause/abi"
+	"github.com/mirzazhar/golang-transfer-clause/clause"
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+// Multicall3Address is the address Multicall3 is deployed at on most of the
+// ethereum-based chains.
+const Multicall3Address = "0xcA11bde05977b3631167028862bE2a173976CA11"
+
+// aggregate3 is the Multicall3 function that executes the calls and returns
+// the success and the return data of each of them.
+const aggregate3 = "aggregate3((address,bool,bytes)[])"
+
+// Call holds a single call of the bundle: the target contract, whether its
+// failure is tolerated and its calldata.
+type Call struct {
+	Target       string
+	AllowFailure bool
+	CallData     []byte
+}
+
+// MulticallBody holds the calls to be aggregated into a single call of
+// Multicall3.
+type MulticallBody struct {
+	address string
+	calls   []Call
+	err     error
+}
+
+// New creates and returns an instance of MulticallBody for Multicall3 at
+// Multicall3Address.
+func New() *MulticallBody {
+	return &MulticallBody{address: Multicall3Address}
+}
+
+// AddAddress adds the address of Multicall3 on the chains it is deployed at
+// another address.
+func (mb *MulticallBody) AddAddress(address string) *MulticallBody {
+	mb.address = address
+	return mb
+}
+
+// AddCall appends the call of the given calldata on the given target contract.
+// Unless its failure is allowed, the failure of the call reverts the bundle.
+func (mb *MulticallBody) AddCall(target string, allowFailure bool, callData []byte) *MulticallBody {
+	mb.calls = append(mb.calls, Call{Target: target, AllowFailure: allowFailure, CallData: callData})
+	return mb
+}
+
+// AddClauseTransform appends the call of the payload of the given method, e.g.
+// the balanceOf of an ERC20Clause, on its token address. Its failure is allowed.
+func (mb *MulticallBody) AddClauseTransform(t clause.ClauseTransform, method string) *MulticallBody {
+	payload, err := t.GetERCPayloadData(method)
+	if err != nil && mb.err == nil {
+		mb.err = err
+	}
+	return mb.AddCall(t.GetTokenAddress(), true, payload)
+}
+
+// Build validates its underlying instance, encodes the aggregate3 calldata and
+// then creates the new instance of Multicall.
+func (mb *MulticallBody) Build() (*Multicall, error) {
+	if mb.err != nil {
+		return nil, mb.err
+	} else if !utils.IsValidAddress(mb.address) {
+		return nil, utils.ErrContractAddress
+	} else if len(mb.calls) == 0 {
+		return nil, utils.ErrCallCount
+	}
+
+	calls := make([]interface{}, len(mb.calls))
+	for i, call := range mb.calls {
+		if !utils.IsValidAddress(call.Target) {
+			return nil, utils.ErrContractAddress
+		}
+		calls[i] = []interface{}{call.Target, call.AllowFailure, call.CallData}
+	}
+
+	data, err := abi.Encode(aggregate3, calls)
+	if err != nil {
+		return nil, err
+	}
+
+	body := *mb
+	body.calls = append([]Call(nil), mb.calls...)
+	return &Multicall{MulticallBody: body, data: data}, nil
+}
+
+// Multicall represents the bundle of calls aggregated into a single call of
+// Multicall3. It implements the clause.ClauseTransform interface, so it can be
+// passed to clause.NewClause or its calldata can be sent via eth_call.
+type Multicall struct {
+	MulticallBody
+	data []byte
+}
+
+// GetTokenAddress returns the address of Multicall3.
+func (mc *Multicall) GetTokenAddress() string {
+	return mc.address
+}
+
+// GetCalls returns the calls of the bundle.
+func (mc *Multicall) GetCalls() []Call {
+	return mc.calls
+}
+
+// GetERCPayloadData returns the aggregate3 calldata of the bundle. The given
+// method must be either aggregate3 or its signature.
+func (mc *Multicall) GetERCPayloadData(method string) ([]byte, error) {
+	if method != "aggregate3" && method != aggregate3 {
+		return nil, utils.NewValidationError(utils.FieldMethod, method, utils.ErrMethod)
+	}
+
+	data := make([]byte, len(mc.data))
+	copy(data, mc.data)
+	return data, nil
+}
+
+// Decode decodes the return data of the aggregate3 call of the bundle into
+// the results of its calls, in the order of the calls.
+func (mc *Multicall) Decode(data []byte) ([]Result, error) {
+	results, err := DecodeAggregate3(data)
+	if err != nil {
+		return nil, err
+	} else if len(results) != len(mc.calls) {
+		return nil, fmt.Errorf("%w: got %d results, wanted %d", utils.ErrReturnDataFormat, len(results), len(mc.calls))
+	}
+	return results, nil
+}
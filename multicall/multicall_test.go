@@ -0,0 +1,103 @@
+package multicall
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/mirzazhar/golang-transfer-clause/clause"
+	"github.com/mirzazhar/golang-transfer-clause/erc20"
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+var (
+	address         string = "0x27d22890587cfada7fec247c5180d73de6c670c4"
+	contractaddress string = "0xdAC17F958D2ee523a2206206994597C13D831ec7"
+)
+
+// aggregate3Data is the calldata of the balanceOf and decimals calls of the
+// token, of which only the failure of the balanceOf is allowed.
+var aggregate3Data string = "82ad56cb" +
+	"0000000000000000000000000000000000000000000000000000000000000020" +
+	"0000000000000000000000000000000000000000000000000000000000000002" +
+	"0000000000000000000000000000000000000000000000000000000000000040" +
+	"0000000000000000000000000000000000000000000000000000000000000100" +
+	"000000000000000000000000dac17f958d2ee523a2206206994597c13d831ec7" +
+	"0000000000000000000000000000000000000000000000000000000000000001" +
+	"0000000000000000000000000000000000000000000000000000000000000060" +
+	"0000000000000000000000000000000000000000000000000000000000000024" +
+	"70a0823100000000000000000000000027d22890587cfada7fec247c5180d73d" +
+	"e6c670c400000000000000000000000000000000000000000000000000000000" +
+	"000000000000000000000000dac17f958d2ee523a2206206994597c13d831ec7" +
+	"0000000000000000000000000000000000000000000000000000000000000000" +
+	"0000000000000000000000000000000000000000000000000000000000000060" +
+	"0000000000000000000000000000000000000000000000000000000000000004" +
+	"313ce56700000000000000000000000000000000000000000000000000000000"
+
+func createMulticall() (*Multicall, error) {
+	erc20clause, err := erc20.New().AddToAddress(address).AddValue("0").AddTokenAddress(contractaddress).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return New().
+		AddClauseTransform(erc20clause, "balanceOf").
+		AddCall(contractaddress, false, erc20clause.TokenDecimals()).
+		Build()
+}
+
+func TestCreateMulticall(t *testing.T) {
+	multicall, err := createMulticall()
+	if err != nil {
+		t.Errorf("cannot create multicall: %v", err)
+	}
+
+	payload, err := multicall.GetERCPayloadData("aggregate3")
+	if err != nil {
+		t.Errorf("cannot create payload: %v", err)
+	}
+
+	if hex.EncodeToString(payload) != aggregate3Data {
+		t.Errorf("got %x, wanted %v", payload, aggregate3Data)
+	}
+
+	if len(multicall.GetCalls()) != 2 || multicall.GetTokenAddress() != Multicall3Address {
+		t.Errorf("got %v, wanted %v", multicall.GetCalls(), 2)
+	}
+
+	// the bundle is a clause of Multicall3.
+	cl, err := clause.NewClause(multicall, "aggregate3")
+	if err != nil {
+		t.Errorf("cannot create clause: %v", err)
+	}
+
+	if cl.GetToAddress() != Multicall3Address || cl.GetData() != aggregate3Data {
+		t.Errorf("got %v, wanted %v", cl.GetToAddress(), Multicall3Address)
+	}
+}
+
+func TestCreateMulticallInvalid(t *testing.T) {
+	erc20clause, err := erc20.New().AddToAddress(address).AddValue("0").AddTokenAddress(contractaddress).Build()
+	if err != nil {
+		t.Errorf("cannot create erc20clause: %v", err)
+	}
+
+	tests := []struct {
+		body *MulticallBody
+		err  error
+	}{
+		{New(), utils.ErrCallCount},
+		{New().AddAddress("0x01").AddCall(contractaddress, true, nil), utils.ErrContractAddress},
+		{New().AddCall("0x01", true, nil), utils.ErrContractAddress},
+	}
+
+	for _, test := range tests {
+		_, err := test.body.Build()
+		if err != test.err {
+			t.Errorf("got %v, wanted %v", err, test.err)
+		}
+	}
+
+	if _, err := New().AddClauseTransform(erc20clause, "mint").Build(); err == nil {
+		t.Errorf("got %v, wanted an error", err)
+	}
+}
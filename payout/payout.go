@@ -0,0 +1,203 @@
+package payout
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/mirzazhar/golang-transfer-clause/clause"
+	"github.com/mirzazhar/golang-transfer-clause/erc20"
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+// Record holds a single payment of the payout: the recipient address and the
+// amount to be paid.
+type Record struct {
+	Address string
+	Amount  string
+}
+
+// RowError represents the error of a single row of the payout.
+type RowError struct {
+	Line int
+	Err  error
+}
+
+// Error returns the line number along with the error of the row.
+func (e *RowError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+// Unwrap returns the error of the row.
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
+
+// RowErrors holds the errors of all the invalid rows of the payout.
+type RowErrors []*RowError
+
+// Error returns the errors of the rows, one per line.
+func (e RowErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "\n")
+}
+
+// PayoutBody holds the necessary information to turn the payments of a payout
+// into clauses: the token, if any, and how the amounts are given.
+type PayoutBody struct {
+	tokenAddress   string
+	unit           clause.Unit
+	decimalAmounts bool
+	header         bool
+	strict         bool
+}
+
+// New creates and returns an instance of PayoutBody that pays in the native
+// coin, with the amounts given in Ether unless a unit is added.
+func New() *PayoutBody {
+	return &PayoutBody{unit: clause.Ether}
+}
+
+// AddTokenAddress adds the address of the ERC-20 token to pay in, instead of
+// the native coin. The amounts are given in base units of the token unless
+// decimal amounts are enabled.
+func (pb *PayoutBody) AddTokenAddress(tokenAddr string) *PayoutBody {
+	pb.tokenAddress = tokenAddr
+	return pb
+}
+
+// AddUnit adds the unit of the amounts of the native coin, e.g. Wei or Gwei.
+func (pb *PayoutBody) AddUnit(unit clause.Unit) *PayoutBody {
+	pb.unit = unit
+	return pb
+}
+
+// AddDecimalAmounts enables or disables the human-readable amounts of the
+// ERC-20 token, e.g. 12.5, which are converted by the decimals registered in
+// erc20.ER20TokenDecimals.
+func (pb *PayoutBody) AddDecimalAmounts(decimalAmounts bool) *PayoutBody {
+	pb.decimalAmounts = decimalAmounts
+	return pb
+}
+
+// AddHeader tells whether the first row of the CSV is a header to be skipped.
+func (pb *PayoutBody) AddHeader(header bool) *PayoutBody {
+	pb.header = header
+	return pb
+}
+
+// AddStrictChecksum enables or disables the strict validation of the EIP-55
+// checksum of the recipient addresses.
+func (pb *PayoutBody) AddStrictChecksum(strict bool) *PayoutBody {
+	pb.strict = strict
+	return pb
+}
+
+// ReadCSV reads the payments from the given CSV of the address and the amount
+// per row; any further columns are ignored and the lines starting with # are
+// comments. It returns the clauses of the valid rows and, if any row is
+// invalid, RowErrors holding the line number of each of them.
+func (pb *PayoutBody) ReadCSV(r io.Reader) ([]*clause.Clause, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+
+	var clauses []*clause.Clause
+	var rowErrors RowErrors
+	for first := true; ; first = false {
+		fields, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				rowErrors = append(rowErrors, &RowError{Line: parseErr.Line, Err: parseErr.Err})
+				continue
+			}
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+		if first && pb.header {
+			continue
+		} else if len(fields) < 2 {
+			err := utils.NewValidationError(utils.FieldRow, strings.Join(fields, ","), utils.ErrPayoutRow)
+			rowErrors = append(rowErrors, &RowError{Line: line, Err: err})
+			continue
+		}
+
+		cl, err := pb.build(Record{Address: strings.TrimSpace(fields[0]), Amount: strings.TrimSpace(fields[1])})
+		if err != nil {
+			rowErrors = append(rowErrors, &RowError{Line: line, Err: err})
+			continue
+		}
+		clauses = append(clauses, cl)
+	}
+
+	if len(rowErrors) > 0 {
+		return clauses, rowErrors
+	}
+	return clauses, nil
+}
+
+// Build turns the given records into clauses. It returns the clauses of the
+// valid records and, if any record is invalid, RowErrors holding the line
+// number of each of them, i.e., its index plus one.
+func (pb *PayoutBody) Build(records []Record) ([]*clause.Clause, error) {
+	var clauses []*clause.Clause
+	var rowErrors RowErrors
+	for i, record := range records {
+		cl, err := pb.build(record)
+		if err != nil {
+			rowErrors = append(rowErrors, &RowError{Line: i + 1, Err: err})
+			continue
+		}
+		clauses = append(clauses, cl)
+	}
+
+	if len(rowErrors) > 0 {
+		return clauses, rowErrors
+	}
+	return clauses, nil
+}
+
+// build validates the given record with the Build of the clause or the
+// ERC-20 clause and returns its clause.
+func (pb *PayoutBody) build(record Record) (*clause.Clause, error) {
+	if pb.tokenAddress == "" {
+		return clause.New().
+			AddToAddress(record.Address).
+			AddValue(record.Amount).
+			AddUnit(pb.unit).
+			AddStrictChecksum(pb.strict).
+			Build()
+	}
+
+	// the ERC-20 clause leaves the recipient to its payload, so it is
+	// validated beforehand like the clause does.
+	if !utils.IsValidAddress(record.Address) {
+		return nil, utils.NewValidationError(utils.FieldTo, record.Address, utils.ErrToAddress)
+	}
+
+	body := erc20.New().
+		AddToAddress(record.Address).
+		AddTokenAddress(pb.tokenAddress).
+		AddStrictChecksum(pb.strict)
+	if pb.decimalAmounts {
+		body.AddAmount(record.Amount)
+	} else {
+		body.AddValue(record.Amount)
+	}
+
+	erc20clause, err := body.Build()
+	if err != nil {
+		return nil, err
+	}
+	return clause.NewClause(erc20clause, "transfer")
+}
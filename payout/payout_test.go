@@ -0,0 +1,126 @@
+package payout
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mirzazhar/golang-transfer-clause/clause"
+	"github.com/mirzazhar/golang-transfer-clause/erc20"
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+var contractaddress string = "0xdAC17F958D2ee523a2206206994597C13D831ec7"
+
+var payroll string = `address,amount
+0x27d22890587cfada7fec247c5180d73de6c670c4, 1.5
+# contractors
+0xcf3aa1a77fa8c221f80bd15f4d7a36186eeb7df1,0.25,march
+
+0x27d22890587cfada7fec247c5180d73de6c670,1
+0xcf3aa1a77fa8c221f80bd15f4d7a36186eeb7df1,-3
+0x27d22890587cfada7fec247c5180d73de6c670c4
+`
+
+func TestReadCSVNativeCoin(t *testing.T) {
+	clauses, err := New().AddHeader(true).ReadCSV(strings.NewReader(payroll))
+
+	var rowErrors RowErrors
+	if !errors.As(err, &rowErrors) {
+		t.Fatalf("got %v, wanted %v", err, "row errors")
+	}
+
+	expectedLines := []int{6, 7, 8}
+	if len(rowErrors) != len(expectedLines) {
+		t.Fatalf("got %v, wanted %v", rowErrors, expectedLines)
+	}
+
+	for i, rowErr := range rowErrors {
+		if rowErr.Line != expectedLines[i] {
+			t.Errorf("got %v, wanted %v", rowErr.Line, expectedLines[i])
+		}
+	}
+
+	wanted := []error{utils.ErrToAddress, utils.ErrValue, utils.ErrPayoutRow}
+	for i, err := range wanted {
+		var validationErr *utils.ValidationError
+		if !errors.As(rowErrors[i], &validationErr) || validationErr.Err != err {
+			t.Errorf("got %v, wanted %v", rowErrors[i], err)
+		}
+	}
+
+	expected := []string{"1500000000000000000", "250000000000000000"}
+	if len(clauses) != len(expected) {
+		t.Fatalf("got %v, wanted %v", len(clauses), len(expected))
+	}
+
+	for i, cl := range clauses {
+		if cl.GetWei().String() != expected[i] {
+			t.Errorf("got %v, wanted %v", cl.GetWei(), expected[i])
+		}
+	}
+}
+
+func TestReadCSVUnit(t *testing.T) {
+	clauses, err := New().AddUnit(clause.Gwei).ReadCSV(strings.NewReader("0x27d22890587cfada7fec247c5180d73de6c670c4,20\n"))
+	if err != nil {
+		t.Errorf("cannot read payout: %v", err)
+	}
+
+	if len(clauses) != 1 || clauses[0].GetWei().String() != "20000000000" {
+		t.Errorf("got %v, wanted %v", clauses, "20000000000")
+	}
+}
+
+func TestReadCSVToken(t *testing.T) {
+	erc20.ER20TokenDecimals[strings.ToLower(contractaddress)] = 6
+	defer delete(erc20.ER20TokenDecimals, strings.ToLower(contractaddress))
+
+	csv := "0x27d22890587cfada7fec247c5180d73de6c670c4,120\n" +
+		"0xdAC17F958D2ee523a2206206994597C13D831ec7,1\n" +
+		"0x27d22890587cfada7fec247c5180d73de6c670,1\n"
+
+	clauses, err := New().AddTokenAddress(contractaddress).AddDecimalAmounts(true).ReadCSV(strings.NewReader(csv))
+
+	var rowErrors RowErrors
+	if !errors.As(err, &rowErrors) || len(rowErrors) != 2 {
+		t.Fatalf("got %v, wanted %v", err, "2 row errors")
+	}
+
+	if !errors.Is(rowErrors[0], utils.ErrSameEOAContractAddr) || rowErrors[0].Line != 2 {
+		t.Errorf("got %v, wanted %v", rowErrors[0], utils.ErrSameEOAContractAddr)
+	}
+
+	var validationErr *utils.ValidationError
+	if !errors.As(rowErrors[1], &validationErr) || validationErr.Err != utils.ErrToAddress || rowErrors[1].Line != 3 {
+		t.Errorf("got %v, wanted %v", rowErrors[1], utils.ErrToAddress)
+	}
+
+	// transfer(0x27d2..., 120000000) to the token.
+	expected := "a9059cbb00000000000000000000000027d22890587cfada7fec247c5180d73de6c670c40000000000000000000000000000000000000000000000000000000007270e00"
+	if len(clauses) != 1 || clauses[0].GetToAddress() != contractaddress || clauses[0].GetData() != expected {
+		t.Errorf("got %v, wanted %v", clauses, expected)
+	}
+}
+
+func TestBuildRecords(t *testing.T) {
+	records := []Record{
+		{Address: "0x27d22890587cfada7fec247c5180d73de6c670c4", Amount: "120000000"},
+		{Address: "0x27d22890587cfada7fec247c5180d73de6c670c4", Amount: "1.5"},
+	}
+
+	clauses, err := New().AddTokenAddress(contractaddress).Build(records)
+
+	var rowErrors RowErrors
+	if !errors.As(err, &rowErrors) || len(rowErrors) != 1 || rowErrors[0].Line != 2 || !errors.Is(rowErrors[0], utils.ErrValue) {
+		t.Errorf("got %v, wanted %v", err, utils.ErrValue)
+	}
+
+	if len(clauses) != 1 {
+		t.Errorf("got %v, wanted %v", len(clauses), 1)
+	}
+
+	if _, err := New().Build(records[:1]); err != nil {
+		t.Errorf("got %v, wanted %v", err, nil)
+	}
+}
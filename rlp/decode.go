@@ -0,0 +1,125 @@
+package rlp
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+// DecodeList decodes the given RLP list and returns its items, each still
+// RLP-encoded. The data must not hold anything but the list.
+func DecodeList(data []byte) ([][]byte, error) {
+	isList, content, rest, err := split(data)
+	if err != nil {
+		return nil, err
+	} else if !isList {
+		return nil, fmt.Errorf("%w: expected a list", utils.ErrRLP)
+	} else if len(rest) != 0 {
+		return nil, fmt.Errorf("%w: trailing bytes after the list", utils.ErrRLP)
+	}
+
+	var items [][]byte
+	for len(content) > 0 {
+		_, _, rest, err := split(content)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, content[:len(content)-len(rest)])
+		content = rest
+	}
+	return items, nil
+}
+
+// DecodeBytes decodes the given RLP string and returns its bytes. The data
+// must not hold anything but the string.
+func DecodeBytes(data []byte) ([]byte, error) {
+	isList, content, rest, err := split(data)
+	if err != nil {
+		return nil, err
+	} else if isList {
+		return nil, fmt.Errorf("%w: expected a string", utils.ErrRLP)
+	} else if len(rest) != 0 {
+		return nil, fmt.Errorf("%w: trailing bytes after the string", utils.ErrRLP)
+	}
+	return content, nil
+}
+
+// DecodeBigInt decodes the given RLP string as a non-negative big integer.
+func DecodeBigInt(data []byte) (*big.Int, error) {
+	content, err := DecodeBytes(data)
+	if err != nil {
+		return nil, err
+	} else if len(content) > 0 && content[0] == 0 {
+		return nil, fmt.Errorf("%w: integer with leading zeros", utils.ErrRLP)
+	}
+	return new(big.Int).SetBytes(content), nil
+}
+
+// DecodeUint decodes the given RLP string as an unsigned integer of 64 bits.
+func DecodeUint(data []byte) (uint64, error) {
+	integer, err := DecodeBigInt(data)
+	if err != nil {
+		return 0, err
+	} else if !integer.IsUint64() {
+		return 0, fmt.Errorf("%w: integer overflows 64 bits", utils.ErrRLP)
+	}
+	return integer.Uint64(), nil
+}
+
+// split reads the first item of the given data and returns whether it is a
+// list, its content and the data that follows it.
+func split(data []byte) (bool, []byte, []byte, error) {
+	if len(data) == 0 {
+		return false, nil, nil, fmt.Errorf("%w: unexpected end of data", utils.ErrRLP)
+	}
+
+	prefix := data[0]
+	switch {
+	case prefix < 0x80:
+		return false, data[:1], data[1:], nil
+	case prefix < 0xb8:
+		content, rest, err := readContent(data[1:], uint64(prefix-0x80))
+		if err == nil && len(content) == 1 && content[0] < 0x80 {
+			err = fmt.Errorf("%w: single byte encoded as a string", utils.ErrRLP)
+		}
+		return false, content, rest, err
+	case prefix < 0xc0:
+		content, rest, err := readLongContent(data[1:], int(prefix-0xb7))
+		return false, content, rest, err
+	case prefix < 0xf8:
+		content, rest, err := readContent(data[1:], uint64(prefix-0xc0))
+		return true, content, rest, err
+	default:
+		content, rest, err := readLongContent(data[1:], int(prefix-0xf7))
+		return true, content, rest, err
+	}
+}
+
+// readLongContent reads the big-endian length of the given number of bytes and
+// then the content of that length.
+func readLongContent(data []byte, lengthSize int) ([]byte, []byte, error) {
+	if len(data) < lengthSize {
+		return nil, nil, fmt.Errorf("%w: unexpected end of data", utils.ErrRLP)
+	} else if data[0] == 0 {
+		return nil, nil, fmt.Errorf("%w: length with leading zeros", utils.ErrRLP)
+	}
+
+	var length uint64
+	for _, b := range data[:lengthSize] {
+		length = length<<8 | uint64(b)
+	}
+
+	if length < 56 {
+		return nil, nil, fmt.Errorf("%w: short length encoded in the long form", utils.ErrRLP)
+	}
+	return readContent(data[lengthSize:], length)
+}
+
+// readContent reads the content of the given length.
+func readContent(data []byte, length uint64) ([]byte, []byte, error) {
+	if uint64(len(data)) < length {
+		return nil, nil, fmt.Errorf("%w: unexpected end of data", utils.ErrRLP)
+	}
+	return data[:length], data[length:], nil
+}
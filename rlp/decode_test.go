@@ -0,0 +1,98 @@
+package rlp
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+func TestDecodeList(t *testing.T) {
+	data := EncodeList(EncodeUint(9), EncodeBytes([]byte("dog")), EncodeList(), EncodeBytes(make([]byte, 56)))
+	items, err := DecodeList(data)
+	if err != nil {
+		t.Errorf("cannot decode list: %v", err)
+	}
+
+	expected := [][]byte{{0x09}, {0x83, 'd', 'o', 'g'}, {0xc0}, EncodeBytes(make([]byte, 56))}
+	if !reflect.DeepEqual(items, expected) {
+		t.Errorf("got %x, wanted %x", items, expected)
+	}
+
+	if _, err := DecodeList(EncodeBytes([]byte("dog"))); !errors.Is(err, utils.ErrRLP) {
+		t.Errorf("got %v, wanted %v", err, utils.ErrRLP)
+	}
+}
+
+func TestDecodeBytes(t *testing.T) {
+	tests := map[string]string{
+		"80":                              "",
+		"00":                              "00",
+		"8180":                            "80",
+		"83646f67":                        "646f67",
+		"b838" + strings.Repeat("61", 56): strings.Repeat("61", 56),
+	}
+
+	for data, expected := range tests {
+		encoded, _ := hex.DecodeString(data)
+		decoded, err := DecodeBytes(encoded)
+		if err != nil {
+			t.Errorf("cannot decode %v: %v", data, err)
+		}
+
+		if hex.EncodeToString(decoded) != expected {
+			t.Errorf("got %x, wanted %v", decoded, expected)
+		}
+	}
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	tests := []string{
+		"",
+		"8100",                            // single byte encoded as a string
+		"83646f",                          // truncated string
+		"b80161",                          // short length encoded in the long form
+		"b9003861",                        // length with leading zeros
+		"c0",                              // list instead of a string
+		"8080",                            // trailing bytes
+		"b838" + strings.Repeat("61", 55), // truncated long string
+	}
+
+	for _, data := range tests {
+		encoded, _ := hex.DecodeString(data)
+		if _, err := DecodeBytes(encoded); !errors.Is(err, utils.ErrRLP) {
+			t.Errorf("got %v, wanted %v", err, utils.ErrRLP)
+		}
+	}
+}
+
+func TestDecodeBigInt(t *testing.T) {
+	tests := map[string]string{
+		"80":                       "0",
+		"0f":                       "15",
+		"820400":                   "1024",
+		"8b0100000000000000000000": "1208925819614629174706176",
+	}
+
+	for data, expected := range tests {
+		encoded, _ := hex.DecodeString(data)
+		integer, err := DecodeBigInt(encoded)
+		if err != nil {
+			t.Errorf("cannot decode %v: %v", data, err)
+		} else if integer.String() != expected {
+			t.Errorf("got %v, wanted %v", integer, expected)
+		}
+	}
+
+	if _, err := DecodeBigInt([]byte{0x82, 0x00, 0x01}); !errors.Is(err, utils.ErrRLP) {
+		t.Errorf("got %v, wanted %v", err, utils.ErrRLP)
+	}
+
+	if _, err := DecodeUint(EncodeBigInt(new(big.Int).Lsh(big.NewInt(1), 64))); !errors.Is(err, utils.ErrRLP) {
+		t.Errorf("got %v, wanted %v", err, utils.ErrRLP)
+	}
+}
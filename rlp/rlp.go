@@ -0,0 +1,58 @@
+package rlp
+
+import (
+	"math/big"
+)
+
+// EncodeBytes encodes the given byte array as an RLP string.
+func EncodeBytes(data []byte) []byte {
+	if len(data) == 1 && data[0] < 0x80 {
+		return []byte{data[0]}
+	}
+	return append(encodeLength(len(data), 0x80), data...)
+}
+
+// EncodeUint encodes the given unsigned integer as an RLP string of its
+// big-endian bytes without leading zeros.
+func EncodeUint(value uint64) []byte {
+	return EncodeBytes(uintBytes(value))
+}
+
+// EncodeBigInt encodes the given non-negative big integer as an RLP string of
+// its big-endian bytes without leading zeros. The nil integer is encoded as zero.
+func EncodeBigInt(value *big.Int) []byte {
+	if value == nil {
+		return EncodeBytes(nil)
+	}
+	return EncodeBytes(value.Bytes())
+}
+
+// EncodeList encodes the given items, each already RLP-encoded, as an RLP list.
+func EncodeList(items ...[]byte) []byte {
+	var payload []byte
+	for _, item := range items {
+		payload = append(payload, item...)
+	}
+	return append(encodeLength(len(payload), 0xc0), payload...)
+}
+
+// encodeLength encodes the prefix of a string or list of the given length
+// using the given offset; 0x80 for strings and 0xc0 for lists.
+func encodeLength(length int, offset byte) []byte {
+	if length < 56 {
+		return []byte{offset + byte(length)}
+	}
+
+	lengthBytes := uintBytes(uint64(length))
+	return append([]byte{offset + 55 + byte(len(lengthBytes))}, lengthBytes...)
+}
+
+// uintBytes returns the big-endian bytes of the given unsigned integer without
+// leading zeros.
+func uintBytes(value uint64) []byte {
+	var data []byte
+	for ; value > 0; value >>= 8 {
+		data = append([]byte{byte(value)}, data...)
+	}
+	return data
+}
@@ -0,0 +1,73 @@
+package rlp
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestEncodeBytes(t *testing.T) {
+	tests := map[string]string{
+		"":                       "80",
+		"00":                     "00",
+		"7f":                     "7f",
+		"80":                     "8180",
+		"646f67":                 "83646f67",
+		strings.Repeat("61", 56): "b838" + strings.Repeat("61", 56),
+	}
+
+	for data, expected := range tests {
+		decoded, _ := hex.DecodeString(data)
+		encoded := hex.EncodeToString(EncodeBytes(decoded))
+		if encoded != expected {
+			t.Errorf("got %v, wanted %v", encoded, expected)
+		}
+	}
+}
+
+func TestEncodeUint(t *testing.T) {
+	tests := map[uint64]string{
+		0:          "80",
+		1:          "01",
+		127:        "7f",
+		128:        "8180",
+		1024:       "820400",
+		0xffffffff: "84ffffffff",
+	}
+
+	for value, expected := range tests {
+		encoded := hex.EncodeToString(EncodeUint(value))
+		if encoded != expected {
+			t.Errorf("got %v, wanted %v", encoded, expected)
+		}
+
+		encoded = hex.EncodeToString(EncodeBigInt(new(big.Int).SetUint64(value)))
+		if encoded != expected {
+			t.Errorf("got %v, wanted %v", encoded, expected)
+		}
+	}
+
+	if encoded := hex.EncodeToString(EncodeBigInt(nil)); encoded != "80" {
+		t.Errorf("got %v, wanted %v", encoded, "80")
+	}
+}
+
+func TestEncodeList(t *testing.T) {
+	encoded := hex.EncodeToString(EncodeList())
+	if encoded != "c0" {
+		t.Errorf("got %v, wanted %v", encoded, "c0")
+	}
+
+	// the set theoretical representation of three: [ [], [[]], [ [], [[]] ] ]
+	three := EncodeList(EncodeList(), EncodeList(EncodeList()), EncodeList(EncodeList(), EncodeList(EncodeList())))
+	encoded = hex.EncodeToString(three)
+	if encoded != "c7c0c1c0c3c0c1c0" {
+		t.Errorf("got %v, wanted %v", encoded, "c7c0c1c0c3c0c1c0")
+	}
+
+	long := EncodeList(EncodeBytes([]byte(strings.Repeat("a", 60))))
+	if hex.EncodeToString(long[:3]) != "f83e"+"b8" {
+		t.Errorf("got %v, wanted %v", hex.EncodeToString(long[:3]), "f83eb8")
+	}
+}
@@ -0,0 +1,136 @@
+package selector
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/mirzazhar/golang-transfer-clause/abi"
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+// Selector is the method ID of 4 bytes that calldata starts with.
+type Selector [4]byte
+
+// String returns the selector hex-encoded with prefix 0x.
+func (s Selector) String() string {
+	return "0x" + hex.EncodeToString(s[:])
+}
+
+// Registry maps function signatures to their selectors and back. It is safe
+// for concurrent use.
+type Registry struct {
+	mu         sync.RWMutex
+	selectors  map[string]Selector
+	signatures map[Selector][]string
+}
+
+// NewRegistry creates and returns an empty instance of Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		selectors:  make(map[string]Selector),
+		signatures: make(map[Selector][]string),
+	}
+}
+
+// Default is the registry the token standards of this library register their
+// signatures in; user signatures can be registered alongside them.
+var Default = NewRegistry()
+
+// Register parses the given function signature, e.g. transfer(address, uint),
+// and registers its canonical form under its selector, which is returned.
+// Registering the same signature again has no effect. If another signature
+// already shares the selector, both stay registered as candidates and an
+// error wrapping ErrSelectorCollision is returned along with the selector.
+func (r *Registry) Register(signature string) (Selector, error) {
+	method, err := abi.ParseMethod(signature)
+	if err != nil {
+		return Selector{}, err
+	}
+
+	canonical := method.Signature()
+	selector := Selector(utils.MethodID(canonical))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.selectors[canonical]; ok {
+		return selector, nil
+	}
+
+	others := r.signatures[selector]
+	r.selectors[canonical] = selector
+	r.signatures[selector] = append(others, canonical)
+	sort.Strings(r.signatures[selector])
+
+	if len(others) > 0 {
+		return selector, fmt.Errorf("%w: %s and %s share %s", utils.ErrSelectorCollision,
+			canonical, strings.Join(others, ", "), selector)
+	}
+	return selector, nil
+}
+
+// Selector returns the selector of the given registered signature.
+func (r *Registry) Selector(signature string) (Selector, bool) {
+	method, err := abi.ParseMethod(signature)
+	if err != nil {
+		return Selector{}, false
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	selector, ok := r.selectors[method.Signature()]
+	return selector, ok
+}
+
+// Lookup returns the registered signatures of the given selector in
+// alphabetical order; more than one signature means a collision.
+func (r *Registry) Lookup(selector Selector) []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return append([]string(nil), r.signatures[selector]...)
+}
+
+// LookupCalldata returns the registered signatures of the selector the given
+// calldata starts with.
+func (r *Registry) LookupCalldata(data []byte) []string {
+	if len(data) < 4 {
+		return nil
+	}
+
+	var selector Selector
+	copy(selector[:], data)
+	return r.Lookup(selector)
+}
+
+// Collisions returns the selectors shared by more than one registered
+// signature along with those signatures.
+func (r *Registry) Collisions() map[Selector][]string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	collisions := make(map[Selector][]string)
+	for selector, signatures := range r.signatures {
+		if len(signatures) > 1 {
+			collisions[selector] = append([]string(nil), signatures...)
+		}
+	}
+	return collisions
+}
+
+// Signatures returns all the registered signatures in alphabetical order.
+func (r *Registry) Signatures() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	signatures := make([]string, 0, len(r.selectors))
+	for signature := range r.selectors {
+		signatures = append(signatures, signature)
+	}
+	sort.Strings(signatures)
+	return signatures
+}
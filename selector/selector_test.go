@@ -0,0 +1,111 @@
+package selector
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+func TestRegister(t *testing.T) {
+	registry := NewRegistry()
+	selector, err := registry.Register("transfer(address, uint)")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, wanted := selector.String(), "0xa9059cbb"; got != wanted {
+		t.Errorf("got %v, wanted %v", got, wanted)
+	}
+	if got, wanted := registry.Lookup(selector), []string{"transfer(address,uint256)"}; !reflect.DeepEqual(got, wanted) {
+		t.Errorf("got %v, wanted %v", got, wanted)
+	}
+	if got, ok := registry.Selector("transfer(address,uint256)"); !ok || got != selector {
+		t.Errorf("got %v, wanted %v", got, selector)
+	}
+
+	// registering the same signature again is not a collision.
+	if _, err := registry.Register("transfer(address,uint256)"); err != nil {
+		t.Errorf("got %v, wanted %v", err, nil)
+	}
+	if got, wanted := len(registry.Signatures()), 1; got != wanted {
+		t.Errorf("got %v, wanted %v", got, wanted)
+	}
+}
+
+func TestRegisterInvalid(t *testing.T) {
+	registry := NewRegistry()
+	if _, err := registry.Register("transfer(address,"); !errors.Is(err, utils.ErrMethodSignature) {
+		t.Errorf("got %v, wanted %v", err, utils.ErrMethodSignature)
+	}
+	if _, ok := registry.Selector("transfer(address,uint256)"); ok {
+		t.Errorf("got %v, wanted %v", ok, false)
+	}
+}
+
+func TestRegisterCollision(t *testing.T) {
+	registry := NewRegistry()
+	if _, err := registry.Register("transferFrom(address,address,uint256)"); err != nil {
+		t.Fatal(err)
+	}
+
+	selector, err := registry.Register("gasprice_bit_ether(int128)")
+	if !errors.Is(err, utils.ErrSelectorCollision) {
+		t.Errorf("got %v, wanted %v", err, utils.ErrSelectorCollision)
+	}
+	if got, wanted := selector.String(), "0x23b872dd"; got != wanted {
+		t.Errorf("got %v, wanted %v", got, wanted)
+	}
+
+	wanted := []string{"gasprice_bit_ether(int128)", "transferFrom(address,address,uint256)"}
+	if got := registry.Lookup(selector); !reflect.DeepEqual(got, wanted) {
+		t.Errorf("got %v, wanted %v", got, wanted)
+	}
+	if got := registry.Collisions(); !reflect.DeepEqual(got, map[Selector][]string{selector: wanted}) {
+		t.Errorf("got %v, wanted %v", got, wanted)
+	}
+}
+
+func TestLookupCalldata(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register("approve(address,uint256)")
+
+	data := []byte{0x09, 0x5e, 0xa7, 0xb3, 0x00}
+	if got, wanted := registry.LookupCalldata(data), []string{"approve(address,uint256)"}; !reflect.DeepEqual(got, wanted) {
+		t.Errorf("got %v, wanted %v", got, wanted)
+	}
+	if got := registry.LookupCalldata(data[:3]); got != nil {
+		t.Errorf("got %v, wanted %v", got, nil)
+	}
+	if got := registry.LookupCalldata([]byte{0, 0, 0, 0}); len(got) != 0 {
+		t.Errorf("got %v, wanted %v", got, nil)
+	}
+}
+
+func TestRegistryConcurrency(t *testing.T) {
+	registry := NewRegistry()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			signature := "method" + strconv.Itoa(i) + "(uint256)"
+			selector, err := registry.Register(signature)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			registry.Lookup(selector)
+			registry.Signatures()
+		}(i)
+	}
+	wg.Wait()
+
+	if got, wanted := len(registry.Signatures()), 50; got != wanted {
+		t.Errorf("got %v, wanted %v", got, wanted)
+	}
+}
@@ -0,0 +1,43 @@
+package transaction
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/mirzazhar/golang-transfer-clause/rlp"
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+// AccessTuple holds an address and the storage keys the transaction intends to
+// access, as defined by EIP-2930.
+type AccessTuple struct {
+	Address     string
+	StorageKeys []string
+}
+
+// AccessList holds the addresses and storage keys the transaction intends to
+// access. The accessed slots are charged at a discount when listed in advance.
+type AccessList []AccessTuple
+
+// encode validates the access list and returns its RLP encoding:
+// rlp([[address, [storageKey, ...]], ...]).
+func (al AccessList) encode() ([]byte, error) {
+	tuples := make([][]byte, len(al))
+	for i, tuple := range al {
+		address, err := utils.AddresstoBytes(tuple.Address)
+		if err != nil {
+			return nil, utils.ErrAccessList
+		}
+
+		keys := make([][]byte, len(tuple.StorageKeys))
+		for j, storageKey := range tuple.StorageKeys {
+			key, err := hex.DecodeString(strings.TrimPrefix(strings.TrimPrefix(storageKey, "0x"), "0X"))
+			if err != nil || len(key) != 32 {
+				return nil, utils.ErrAccessList
+			}
+			keys[j] = rlp.EncodeBytes(key)
+		}
+		tuples[i] = rlp.EncodeList(rlp.EncodeBytes(address), rlp.EncodeList(keys...))
+	}
+	return rlp.EncodeList(tuples...), nil
+}
@@ -0,0 +1,31 @@
+package transaction
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestAccessListEncode(t *testing.T) {
+	tests := []struct {
+		accessList AccessList
+		expected   string
+	}{
+		{nil, "c0"},
+		{AccessList{{Address: address}}, "d7d6943535353535353535353535353535353535353535c0"},
+		{
+			AccessList{{Address: address, StorageKeys: []string{"0000000000000000000000000000000000000000000000000000000000000001"}}},
+			"f838f7943535353535353535353535353535353535353535e1a00000000000000000000000000000000000000000000000000000000000000001",
+		},
+	}
+
+	for _, test := range tests {
+		encoded, err := test.accessList.encode()
+		if err != nil {
+			t.Errorf("cannot encode access list: %v", err)
+		}
+
+		if hex.EncodeToString(encoded) != test.expected {
+			t.Errorf("got %x, wanted %v", encoded, test.expected)
+		}
+	}
+}
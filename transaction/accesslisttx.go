@@ -0,0 +1,137 @@
+package transaction
+
+import (
+	"math/big"
+
+	"github.com/mirzazhar/golang-transfer-clause/clause"
+	"github.com/mirzazhar/golang-transfer-clause/rlp"
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+// AccessListTxType is the EIP-2718 type of the EIP-2930 transaction.
+const AccessListTxType = 0x01
+
+// AccessListTxBody holds the necessary information to create an EIP-2930
+// transaction out of a clause: the chain id, nonce, gas price, gas limit and
+// access list.
+type AccessListTxBody struct {
+	clause     *clause.Clause
+	chainID    *big.Int
+	nonce      uint64
+	gasPrice   *big.Int
+	gasLimit   uint64
+	accessList AccessList
+}
+
+// NewAccessListTx creates and returns an instance of AccessListTxBody for the given clause.
+func NewAccessListTx(cl *clause.Clause) *AccessListTxBody {
+	return &AccessListTxBody{clause: cl}
+}
+
+// AddChainID adds the chain id of the network the transaction is valid on.
+func (ab *AccessListTxBody) AddChainID(chainID *big.Int) *AccessListTxBody {
+	ab.chainID = chainID
+	return ab
+}
+
+// AddNonce adds the nonce of the sender account.
+func (ab *AccessListTxBody) AddNonce(nonce uint64) *AccessListTxBody {
+	ab.nonce = nonce
+	return ab
+}
+
+// AddGasPrice adds the gas price in wei.
+func (ab *AccessListTxBody) AddGasPrice(gasPrice *big.Int) *AccessListTxBody {
+	ab.gasPrice = gasPrice
+	return ab
+}
+
+// AddGasLimit adds the maximum amount of gas the transaction may consume.
+func (ab *AccessListTxBody) AddGasLimit(gasLimit uint64) *AccessListTxBody {
+	ab.gasLimit = gasLimit
+	return ab
+}
+
+// AddAccessList adds the addresses and storage keys the transaction intends to access.
+func (ab *AccessListTxBody) AddAccessList(accessList AccessList) *AccessListTxBody {
+	ab.accessList = accessList
+	return ab
+}
+
+// Build validates its underlying instance and then creates the new instance
+// of AccessListTransaction.
+func (ab *AccessListTxBody) Build() (*AccessListTransaction, error) {
+	to, value, data, err := clauseFields(ab.clause)
+	if err != nil {
+		return nil, err
+	} else if !isValidAmount(ab.chainID) {
+		return nil, utils.ErrChainID
+	} else if !isValidAmount(ab.gasPrice) {
+		return nil, utils.ErrGasPrice
+	} else if ab.gasLimit == 0 {
+		return nil, utils.ErrGasLimit
+	}
+
+	accessList, err := ab.accessList.encode()
+	if err != nil {
+		return nil, err
+	}
+
+	return &AccessListTransaction{
+		AccessListTxBody: *ab,
+		to:               to,
+		value:            value,
+		data:             data,
+		accessList:       accessList,
+	}, nil
+}
+
+// AccessListTransaction represents the unsigned EIP-2930 transaction of a clause.
+type AccessListTransaction struct {
+	AccessListTxBody
+	to         []byte
+	value      *big.Int
+	data       []byte
+	accessList []byte
+}
+
+// GetChainID returns the chain id of the transaction.
+func (tx *AccessListTransaction) GetChainID() *big.Int {
+	return tx.chainID
+}
+
+// fields returns the RLP-encoded fields of the unsigned transaction.
+func (tx *AccessListTransaction) fields() [][]byte {
+	return [][]byte{
+		rlp.EncodeBigInt(tx.chainID),
+		rlp.EncodeUint(tx.nonce),
+		rlp.EncodeBigInt(tx.gasPrice),
+		rlp.EncodeUint(tx.gasLimit),
+		rlp.EncodeBytes(tx.to),
+		rlp.EncodeBigInt(tx.value),
+		rlp.EncodeBytes(tx.data),
+		tx.accessList,
+	}
+}
+
+// RLP returns the typed envelope of the unsigned transaction:
+// 0x01 || rlp([chainId, nonce, gasPrice, gasLimit, to, value, data, accessList]).
+func (tx *AccessListTransaction) RLP() []byte {
+	return typedEnvelope(AccessListTxType, tx.fields())
+}
+
+// SigningPayload returns the typed envelope to be signed, which equals RLP.
+func (tx *AccessListTransaction) SigningPayload() []byte {
+	return tx.RLP()
+}
+
+// SigningHash returns the keccak-256 hash of the signing payload.
+func (tx *AccessListTransaction) SigningHash() []byte {
+	return utils.Keccak256(tx.SigningPayload())
+}
+
+// signedRLP returns the typed envelope of the transaction signed with the given
+// recovery id and signature, which are appended to the fields as v, r and s.
+func (tx *AccessListTransaction) signedRLP(recoveryID byte, r, s *big.Int) ([]byte, error) {
+	return typedEnvelope(AccessListTxType, append(tx.fields(), rlp.EncodeUint(uint64(recoveryID)), rlp.EncodeBigInt(r), rlp.EncodeBigInt(s))), nil
+}
@@ -0,0 +1,87 @@
+package transaction
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/mirzazhar/golang-transfer-clause/clause"
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+// createAccessListTransaction creates the ether transfer of the block 19431837.
+func createAccessListTransaction() (*AccessListTransaction, error) {
+	cl, err := clause.New().
+		AddToAddress("0xB05178Ed26b624875De845E07a8Eb612D14097E1").
+		AddValue("13162880000000000").
+		AddUnit(clause.Wei).
+		Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewAccessListTx(cl).
+		AddChainID(big.NewInt(1)).
+		AddNonce(683406).
+		AddGasPrice(big.NewInt(44781565682)).
+		AddGasLimit(500000).
+		Build()
+}
+
+func TestAccessListTxMainnet(t *testing.T) {
+	tx, err := createAccessListTransaction()
+	if err != nil {
+		t.Errorf("cannot create transaction: %v", err)
+	}
+
+	hash := hex.EncodeToString(tx.SigningHash())
+	expected := "52a466318569c782d164949565d4b03171c4c5d8f8eb4d5d94452060881c6b7f"
+	if hash != expected {
+		t.Errorf("got %v, wanted %v", hash, expected)
+	}
+
+	r, _ := new(big.Int).SetString("ca6269197e71623f391827c2020871d611e341f74c12ee1d13e274348cf8c996", 16)
+	s, _ := new(big.Int).SetString("189ff08439030629c395d59fbaaa626b4fdc37744b94a30f6773ed3e4a31420f", 16)
+	signed, err := tx.signedRLP(0, r, s)
+	if err != nil {
+		t.Errorf("cannot sign transaction: %v", err)
+	}
+
+	raw := hex.EncodeToString(signed)
+	expected = "01f87101830a6d8e850a6d3076f28307a12094b05178ed26b624875de845e07a8eb612d14097e1872ec391d29f000080c080a0ca6269197e71623f391827c2020871d611e341f74c12ee1d13e274348cf8c996a0189ff08439030629c395d59fbaaa626b4fdc37744b94a30f6773ed3e4a31420f"
+	if raw != expected {
+		t.Errorf("got %v, wanted %v", raw, expected)
+	}
+
+	hash = hex.EncodeToString(utils.Keccak256(signed))
+	expected = "24f52a7e2ca2d7ca3238ec18bcffad4ae813f5edd84de77a43552db88799baf7"
+	if hash != expected {
+		t.Errorf("got %v, wanted %v", hash, expected)
+	}
+}
+
+func TestAccessListTxInvalid(t *testing.T) {
+	cl, err := clause.New().AddToAddress(address).AddValue("1").Build()
+	if err != nil {
+		t.Errorf("cannot create clause: %v", err)
+	}
+
+	tests := []struct {
+		body *AccessListTxBody
+		err  error
+	}{
+		{NewAccessListTx(nil).AddChainID(big.NewInt(1)).AddGasPrice(big.NewInt(1)).AddGasLimit(21000), utils.ErrClause},
+		{NewAccessListTx(cl).AddGasPrice(big.NewInt(1)).AddGasLimit(21000), utils.ErrChainID},
+		{NewAccessListTx(cl).AddChainID(big.NewInt(1)).AddGasLimit(21000), utils.ErrGasPrice},
+		{NewAccessListTx(cl).AddChainID(big.NewInt(1)).AddGasPrice(big.NewInt(1)), utils.ErrGasLimit},
+		{NewAccessListTx(cl).AddChainID(big.NewInt(1)).AddGasPrice(big.NewInt(1)).AddGasLimit(21000).
+			AddAccessList(AccessList{{Address: "0x35"}}), utils.ErrAccessList},
+	}
+
+	for _, test := range tests {
+		_, err := test.body.Build()
+		if err != test.err {
+			t.Errorf("got %v, wanted %v", err, test.err)
+		}
+	}
+}
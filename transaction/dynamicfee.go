@@ -0,0 +1,149 @@
+package transaction
+
+import (
+	"math/big"
+
+	"github.com/mirzazhar/golang-transfer-clause/clause"
+	"github.com/mirzazhar/golang-transfer-clause/rlp"
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+// DynamicFeeTxType is the EIP-2718 type of the EIP-1559 transaction.
+const DynamicFeeTxType = 0x02
+
+// DynamicFeeBody holds the necessary information to create an EIP-1559
+// transaction out of a clause: the chain id, nonce, fees per gas, gas limit
+// and access list.
+type DynamicFeeBody struct {
+	clause               *clause.Clause
+	chainID              *big.Int
+	nonce                uint64
+	maxPriorityFeePerGas *big.Int
+	maxFeePerGas         *big.Int
+	gasLimit             uint64
+	accessList           AccessList
+}
+
+// NewDynamicFee creates and returns an instance of DynamicFeeBody for the given clause.
+func NewDynamicFee(cl *clause.Clause) *DynamicFeeBody {
+	return &DynamicFeeBody{clause: cl}
+}
+
+// AddChainID adds the chain id of the network the transaction is valid on.
+func (db *DynamicFeeBody) AddChainID(chainID *big.Int) *DynamicFeeBody {
+	db.chainID = chainID
+	return db
+}
+
+// AddNonce adds the nonce of the sender account.
+func (db *DynamicFeeBody) AddNonce(nonce uint64) *DynamicFeeBody {
+	db.nonce = nonce
+	return db
+}
+
+// AddMaxPriorityFeePerGas adds the tip per gas in wei paid to the block producer.
+func (db *DynamicFeeBody) AddMaxPriorityFeePerGas(fee *big.Int) *DynamicFeeBody {
+	db.maxPriorityFeePerGas = fee
+	return db
+}
+
+// AddMaxFeePerGas adds the maximum fee per gas in wei, i.e., the base fee plus
+// the tip, the sender is willing to pay.
+func (db *DynamicFeeBody) AddMaxFeePerGas(fee *big.Int) *DynamicFeeBody {
+	db.maxFeePerGas = fee
+	return db
+}
+
+// AddGasLimit adds the maximum amount of gas the transaction may consume.
+func (db *DynamicFeeBody) AddGasLimit(gasLimit uint64) *DynamicFeeBody {
+	db.gasLimit = gasLimit
+	return db
+}
+
+// AddAccessList adds the addresses and storage keys the transaction intends to access.
+func (db *DynamicFeeBody) AddAccessList(accessList AccessList) *DynamicFeeBody {
+	db.accessList = accessList
+	return db
+}
+
+// Build validates its underlying instance and then creates the new instance
+// of DynamicFeeTransaction.
+func (db *DynamicFeeBody) Build() (*DynamicFeeTransaction, error) {
+	to, value, data, err := clauseFields(db.clause)
+	if err != nil {
+		return nil, err
+	} else if !isValidAmount(db.chainID) {
+		return nil, utils.ErrChainID
+	} else if !isValidAmount(db.maxPriorityFeePerGas) || !isValidAmount(db.maxFeePerGas) ||
+		db.maxPriorityFeePerGas.Cmp(db.maxFeePerGas) > 0 {
+		return nil, utils.ErrFeePerGas
+	} else if db.gasLimit == 0 {
+		return nil, utils.ErrGasLimit
+	}
+
+	accessList, err := db.accessList.encode()
+	if err != nil {
+		return nil, err
+	}
+
+	return &DynamicFeeTransaction{
+		DynamicFeeBody: *db,
+		to:             to,
+		value:          value,
+		data:           data,
+		accessList:     accessList,
+	}, nil
+}
+
+// DynamicFeeTransaction represents the unsigned EIP-1559 transaction of a clause.
+type DynamicFeeTransaction struct {
+	DynamicFeeBody
+	to         []byte
+	value      *big.Int
+	data       []byte
+	accessList []byte
+}
+
+// GetChainID returns the chain id of the transaction.
+func (tx *DynamicFeeTransaction) GetChainID() *big.Int {
+	return tx.chainID
+}
+
+// fields returns the RLP-encoded fields of the unsigned transaction.
+func (tx *DynamicFeeTransaction) fields() [][]byte {
+	return [][]byte{
+		rlp.EncodeBigInt(tx.chainID),
+		rlp.EncodeUint(tx.nonce),
+		rlp.EncodeBigInt(tx.maxPriorityFeePerGas),
+		rlp.EncodeBigInt(tx.maxFeePerGas),
+		rlp.EncodeUint(tx.gasLimit),
+		rlp.EncodeBytes(tx.to),
+		rlp.EncodeBigInt(tx.value),
+		rlp.EncodeBytes(tx.data),
+		tx.accessList,
+	}
+}
+
+// RLP returns the typed envelope of the unsigned transaction:
+// 0x02 || rlp([chainId, nonce, maxPriorityFeePerGas, maxFeePerGas, gasLimit,
+// to, value, data, accessList]).
+func (tx *DynamicFeeTransaction) RLP() []byte {
+	return typedEnvelope(DynamicFeeTxType, tx.fields())
+}
+
+// SigningPayload returns the typed envelope to be signed. Unlike the legacy
+// transaction, the chain id is already part of the fields, so it equals RLP.
+func (tx *DynamicFeeTransaction) SigningPayload() []byte {
+	return tx.RLP()
+}
+
+// SigningHash returns the keccak-256 hash of the signing payload.
+func (tx *DynamicFeeTransaction) SigningHash() []byte {
+	return utils.Keccak256(tx.SigningPayload())
+}
+
+// signedRLP returns the typed envelope of the transaction signed with the given
+// recovery id and signature, which are appended to the fields as v, r and s.
+func (tx *DynamicFeeTransaction) signedRLP(recoveryID byte, r, s *big.Int) ([]byte, error) {
+	return typedEnvelope(DynamicFeeTxType, append(tx.fields(), rlp.EncodeUint(uint64(recoveryID)), rlp.EncodeBigInt(r), rlp.EncodeBigInt(s))), nil
+}
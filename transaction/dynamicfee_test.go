@@ -0,0 +1,182 @@
+package transaction
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/mirzazhar/golang-transfer-clause/clause"
+	"github.com/mirzazhar/golang-transfer-clause/rlp"
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+// dynamicFeeTest holds a transaction of the ethereum mainnet along with its
+// signature, signing hash, raw encoding and transaction hash.
+type dynamicFeeTest struct {
+	to, value, data string
+	nonce           uint64
+	tip, maxFee     int64
+	gasLimit        uint64
+	accessList      AccessList
+	v               uint64
+	r, s            string
+	signingHash     string
+	raw             string
+	hash            string
+}
+
+var dynamicFeeTests = []dynamicFeeTest{
+	// USDT transfer of the block 18189758.
+	{
+		to:          "0xdAC17F958D2ee523a2206206994597C13D831ec7",
+		value:       "0",
+		data:        "0xa9059cbb000000000000000000000000cf3aa1a77fa8c221f80bd15f4d7a36186eeb7df10000000000000000000000000000000000000000000000000000000007270e00",
+		nonce:       36,
+		tip:         2000000000,
+		maxFee:      11700759771,
+		gasLimit:    70000,
+		v:           0,
+		r:           "d5701426adcbf17f20353389eeedff7c30420dc3b95b1a105b42f67f45994f8f",
+		s:           "040e87ced08417fa84ca69d6886c06d34cc35655eecd745f70633553cc17884e",
+		signingHash: "edf1b0eee35a7da40d4c2061198ec4f70543bf9b79a8b7947db9d2d2edbb3112",
+		raw:         "02f8b1012484773594008502b96b6cdb8301117094dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb000000000000000000000000cf3aa1a77fa8c221f80bd15f4d7a36186eeb7df10000000000000000000000000000000000000000000000000000000007270e00c080a0d5701426adcbf17f20353389eeedff7c30420dc3b95b1a105b42f67f45994f8fa0040e87ced08417fa84ca69d6886c06d34cc35655eecd745f70633553cc17884e",
+		hash:        "33b2a5fd7c7d1584ca4af1ec8b8dec0d48572c259623923fe6de18e7e1972b35",
+	},
+	// ether transfer of the block 18189758.
+	{
+		to:          "0xDce92f40cAdDE2C4e3EA78b8892c540e6bFe2f81",
+		value:       "40052873593132000",
+		nonce:       1,
+		tip:         2000000000,
+		maxFee:      10339352708,
+		gasLimit:    21000,
+		v:           0,
+		r:           "cab09875ed6df6893ac90891df5252bb0063bdd5b179b3fdce5e403b34d46d2e",
+		s:           "239c71539b9a304b712197b1472c248dcb0e52841fc4aa5887e288fe567b66c9",
+		signingHash: "cf5be95d04a5e108466ed96c15e1f0f315bcdd791c136832583862f3481dd501",
+		raw:         "02f8720101847735940085026846008482520894dce92f40cadde2c4e3ea78b8892c540e6bfe2f81878e4be056c093e080c080a0cab09875ed6df6893ac90891df5252bb0063bdd5b179b3fdce5e403b34d46d2ea0239c71539b9a304b712197b1472c248dcb0e52841fc4aa5887e288fe567b66c9",
+		hash:        "9dd3416f60926ea7887021cbf05a8e4a21111423d3c3a621f86d8738729a7f97",
+	},
+	// transaction with an access list of the block 19431837.
+	{
+		to:       "0x6b75d8AF000000e20B7a7DDf000Ba900b4009A80",
+		value:    "177712086173",
+		data:     "0x7f371ce270557c1f68cfb577b856766310bf8b47fd9c03cafc6d06",
+		nonce:    2240109,
+		tip:      942151136271,
+		maxFee:   942151136271,
+		gasLimit: 107445,
+		accessList: AccessList{
+			{
+				Address: "0x1ce270557C1f68Cfb577b856766310Bf8B47FD9C",
+				StorageKeys: []string{
+					"0xb39e9ba92c3c47c76d4f70e3bc9c3270ab78d2592718d377c8f5433a34d3470a",
+					"0x94fe3377ad59f5716da176e7699b06460ce5b4208f8313f3d26113b1cf3d3170",
+				},
+			},
+			{
+				Address: "0x7054b0F980a7EB5B3a6B3446F3c947D80162775C",
+				StorageKeys: []string{
+					"0x000000000000000000000000000000000000000000000000000000000000000c",
+					"0x0000000000000000000000000000000000000000000000000000000000000008",
+					"0x0000000000000000000000000000000000000000000000000000000000000006",
+					"0x0000000000000000000000000000000000000000000000000000000000000007",
+				},
+			},
+			{
+				Address: "0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2",
+				StorageKeys: []string{
+					"0x12231cd4c753cb5530a43a74c45106c24765e6f81dc8927d4f4be7e53315d5a8",
+					"0x051234925bf172ac8e2ccbd292c65330169d67445a0966551f13a5df19bb9321",
+				},
+			},
+		},
+		v:           1,
+		r:           "e432951488a733bc6f10ac87caeff25ebfa3693ee0362134588b580d99d358d3",
+		s:           "036bbca9bf15f825e87ed5d98bcc4d56039f1e72a6647b87a44be152bf7445a8",
+		signingHash: "e2a0b0149128129ad8cabc92c36a908eace63028b45cfdfeff24b39c524b2f8c",
+		raw:         "02f901e50183222e6d85db5c95740f85db5c95740f8301a3b5946b75d8af000000e20b7a7ddf000ba900b4009a80852960773c9d9b7f371ce270557c1f68cfb577b856766310bf8b47fd9c03cafc6d06f90153f859941ce270557c1f68cfb577b856766310bf8b47fd9cf842a0b39e9ba92c3c47c76d4f70e3bc9c3270ab78d2592718d377c8f5433a34d3470aa094fe3377ad59f5716da176e7699b06460ce5b4208f8313f3d26113b1cf3d3170f89b947054b0f980a7eb5b3a6b3446f3c947d80162775cf884a0000000000000000000000000000000000000000000000000000000000000000ca00000000000000000000000000000000000000000000000000000000000000008a00000000000000000000000000000000000000000000000000000000000000006a00000000000000000000000000000000000000000000000000000000000000007f85994c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2f842a012231cd4c753cb5530a43a74c45106c24765e6f81dc8927d4f4be7e53315d5a8a0051234925bf172ac8e2ccbd292c65330169d67445a0966551f13a5df19bb932101a0e432951488a733bc6f10ac87caeff25ebfa3693ee0362134588b580d99d358d3a0036bbca9bf15f825e87ed5d98bcc4d56039f1e72a6647b87a44be152bf7445a8",
+		hash:        "94703bf2ce332ee78a1da5d2a2dd61361d269129631e1cf11dc3e18b3de8ec64",
+	},
+}
+
+func TestDynamicFeeMainnet(t *testing.T) {
+	for _, test := range dynamicFeeTests {
+		cl, err := clause.New().AddToAddress(test.to).AddValue(test.value).AddUnit(clause.Wei).AddData(test.data).Build()
+		if err != nil {
+			t.Errorf("cannot create clause: %v", err)
+			continue
+		}
+
+		tx, err := NewDynamicFee(cl).
+			AddChainID(big.NewInt(1)).
+			AddNonce(test.nonce).
+			AddMaxPriorityFeePerGas(big.NewInt(test.tip)).
+			AddMaxFeePerGas(big.NewInt(test.maxFee)).
+			AddGasLimit(test.gasLimit).
+			AddAccessList(test.accessList).
+			Build()
+		if err != nil {
+			t.Errorf("cannot create transaction: %v", err)
+			continue
+		}
+
+		hash := hex.EncodeToString(tx.SigningHash())
+		if hash != test.signingHash {
+			t.Errorf("got %v, wanted %v", hash, test.signingHash)
+		}
+
+		// the signed transaction appends the signature to the fields.
+		r, _ := hex.DecodeString(test.r)
+		s, _ := hex.DecodeString(test.s)
+		fields := append(tx.fields(), rlp.EncodeUint(test.v), rlp.EncodeBigInt(new(big.Int).SetBytes(r)), rlp.EncodeBigInt(new(big.Int).SetBytes(s)))
+		signed := append([]byte{DynamicFeeTxType}, rlp.EncodeList(fields...)...)
+
+		raw := hex.EncodeToString(signed)
+		if raw != test.raw {
+			t.Errorf("got %v, wanted %v", raw, test.raw)
+		}
+
+		hash = hex.EncodeToString(utils.Keccak256(signed))
+		if hash != test.hash {
+			t.Errorf("got %v, wanted %v", hash, test.hash)
+		}
+	}
+}
+
+func TestDynamicFeeInvalid(t *testing.T) {
+	cl, err := clause.New().AddToAddress(address).AddValue("1").Build()
+	if err != nil {
+		t.Errorf("cannot create clause: %v", err)
+	}
+
+	valid := func() *DynamicFeeBody {
+		return NewDynamicFee(cl).
+			AddChainID(big.NewInt(1)).
+			AddMaxPriorityFeePerGas(big.NewInt(1)).
+			AddMaxFeePerGas(big.NewInt(2)).
+			AddGasLimit(21000)
+	}
+
+	tests := []struct {
+		body *DynamicFeeBody
+		err  error
+	}{
+		{valid(), nil},
+		{NewDynamicFee(nil), utils.ErrClause},
+		{valid().AddChainID(nil), utils.ErrChainID},
+		{valid().AddMaxFeePerGas(nil), utils.ErrFeePerGas},
+		{valid().AddMaxPriorityFeePerGas(big.NewInt(-1)), utils.ErrFeePerGas},
+		{valid().AddMaxPriorityFeePerGas(big.NewInt(3)), utils.ErrFeePerGas},
+		{valid().AddGasLimit(0), utils.ErrGasLimit},
+		{valid().AddAccessList(AccessList{{Address: "0x35"}}), utils.ErrAccessList},
+		{valid().AddAccessList(AccessList{{Address: address, StorageKeys: []string{"0x01"}}}), utils.ErrAccessList},
+	}
+
+	for _, test := range tests {
+		_, err := test.body.Build()
+		if err != test.err {
+			t.Errorf("got %v, wanted %v", err, test.err)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package transaction
+
+import (
+	"math/big"
+
+	"github.com/mirzazhar/golang-transfer-clause/clause"
+	"github.com/mirzazhar/golang-transfer-clause/rlp"
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+// LegacyBody holds the necessary information to create a legacy transaction
+// out of a clause: the nonce, gas price, gas limit and chain id.
+type LegacyBody struct {
+	clause   *clause.Clause
+	nonce    uint64
+	gasPrice *big.Int
+	gasLimit uint64
+	chainID  *big.Int
+}
+
+// NewLegacy creates and returns an instance of LegacyBody for the given clause.
+func NewLegacy(cl *clause.Clause) *LegacyBody {
+	return &LegacyBody{clause: cl}
+}
+
+// AddNonce adds the nonce of the sender account.
+func (lb *LegacyBody) AddNonce(nonce uint64) *LegacyBody {
+	lb.nonce = nonce
+	return lb
+}
+
+// AddGasPrice adds the gas price in wei.
+func (lb *LegacyBody) AddGasPrice(gasPrice *big.Int) *LegacyBody {
+	lb.gasPrice = gasPrice
+	return lb
+}
+
+// AddGasLimit adds the maximum amount of gas the transaction may consume.
+func (lb *LegacyBody) AddGasLimit(gasLimit uint64) *LegacyBody {
+	lb.gasLimit = gasLimit
+	return lb
+}
+
+// AddChainID adds the chain id used by the EIP-155 replay protection. Without
+// a chain id, the transaction is signed as the pre-EIP-155 transaction.
+func (lb *LegacyBody) AddChainID(chainID *big.Int) *LegacyBody {
+	lb.chainID = chainID
+	return lb
+}
+
+// Build validates its underlying instance and then creates the new instance
+// of LegacyTransaction.
+func (lb *LegacyBody) Build() (*LegacyTransaction, error) {
+	to, value, data, err := clauseFields(lb.clause)
+	if err != nil {
+		return nil, err
+	} else if !isValidAmount(lb.gasPrice) {
+		return nil, utils.ErrGasPrice
+	} else if lb.gasLimit == 0 {
+		return nil, utils.ErrGasLimit
+	} else if lb.chainID != nil && lb.chainID.Sign() < 0 {
+		return nil, utils.ErrChainID
+	}
+
+	return &LegacyTransaction{
+		LegacyBody: *lb,
+		to:         to,
+		value:      value,
+		data:       data,
+	}, nil
+}
+
+// LegacyTransaction represents the unsigned legacy ethereum transaction of a clause.
+type LegacyTransaction struct {
+	LegacyBody
+	to    []byte
+	value *big.Int
+	data  []byte
+}
+
+// GetChainID returns the chain id of the transaction; nil for the pre-EIP-155
+// transaction.
+func (tx *LegacyTransaction) GetChainID() *big.Int {
+	return tx.chainID
+}
+
+// fields returns the RLP-encoded fields of the unsigned transaction.
+func (tx *LegacyTransaction) fields() [][]byte {
+	return [][]byte{
+		rlp.EncodeUint(tx.nonce),
+		rlp.EncodeBigInt(tx.gasPrice),
+		rlp.EncodeUint(tx.gasLimit),
+		rlp.EncodeBytes(tx.to),
+		rlp.EncodeBigInt(tx.value),
+		rlp.EncodeBytes(tx.data),
+	}
+}
+
+// RLP returns the RLP encoding of the unsigned transaction:
+// rlp([nonce, gasPrice, gasLimit, to, value, data]).
+func (tx *LegacyTransaction) RLP() []byte {
+	return rlp.EncodeList(tx.fields()...)
+}
+
+// SigningPayload returns the RLP encoding to be signed. With a chain id, it
+// is extended by [chainId, 0, 0] as defined by EIP-155.
+func (tx *LegacyTransaction) SigningPayload() []byte {
+	fields := tx.fields()
+	if tx.chainID != nil && tx.chainID.Sign() > 0 {
+		fields = append(fields, rlp.EncodeBigInt(tx.chainID), rlp.EncodeUint(0), rlp.EncodeUint(0))
+	}
+	return rlp.EncodeList(fields...)
+}
+
+// SigningHash returns the keccak-256 hash of the signing payload.
+func (tx *LegacyTransaction) SigningHash() []byte {
+	return utils.Keccak256(tx.SigningPayload())
+}
+
+// signedRLP returns the RLP encoding of the transaction signed with the given
+// recovery id and signature: rlp([nonce, gasPrice, gasLimit, to, value, data,
+// v, r, s]), where v also encodes the chain id as defined by EIP-155.
+func (tx *LegacyTransaction) signedRLP(recoveryID byte, r, s *big.Int) ([]byte, error) {
+	v := big.NewInt(int64(recoveryID) + 27)
+	if tx.chainID != nil && tx.chainID.Sign() > 0 {
+		v = new(big.Int).Mul(tx.chainID, big.NewInt(2))
+		v.Add(v, big.NewInt(int64(recoveryID)+35))
+	}
+	return rlp.EncodeList(append(tx.fields(), rlp.EncodeBigInt(v), rlp.EncodeBigInt(r), rlp.EncodeBigInt(s))...), nil
+}
@@ -0,0 +1,109 @@
+package transaction
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/mirzazhar/golang-transfer-clause/clause"
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+var address string = "0x3535353535353535353535353535353535353535"
+
+// createLegacyTransaction creates the transaction of the EIP-155 example.
+func createLegacyTransaction(chainID *big.Int) (*LegacyTransaction, error) {
+	cl, err := clause.New().AddToAddress(address).AddValue("1").AddUnit(clause.Ether).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewLegacy(cl).
+		AddNonce(9).
+		AddGasPrice(big.NewInt(20000000000)).
+		AddGasLimit(21000).
+		AddChainID(chainID).
+		Build()
+}
+
+func TestLegacySigningHash(t *testing.T) {
+	tx, err := createLegacyTransaction(big.NewInt(1))
+	if err != nil {
+		t.Errorf("cannot create transaction: %v", err)
+	}
+
+	payload := hex.EncodeToString(tx.SigningPayload())
+	expected := "ec098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a764000080018080"
+	if payload != expected {
+		t.Errorf("got %v, wanted %v", payload, expected)
+	}
+
+	hash := hex.EncodeToString(tx.SigningHash())
+	expected = "daf5a779ae972f972197303d7b574746c7ef83eadac0f2791ad23db92e4c8e53"
+	if hash != expected {
+		t.Errorf("got %v, wanted %v", hash, expected)
+	}
+
+	encoded := hex.EncodeToString(tx.RLP())
+	expected = "e9098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a764000080"
+	if encoded != expected {
+		t.Errorf("got %v, wanted %v", encoded, expected)
+	}
+}
+
+func TestLegacySigningHashWithoutChainID(t *testing.T) {
+	tx, err := createLegacyTransaction(nil)
+	if err != nil {
+		t.Errorf("cannot create transaction: %v", err)
+	}
+
+	payload := hex.EncodeToString(tx.SigningPayload())
+	expected := hex.EncodeToString(tx.RLP())
+	if payload != expected {
+		t.Errorf("got %v, wanted %v", payload, expected)
+	}
+}
+
+func TestLegacyInvalid(t *testing.T) {
+	cl, err := clause.New().AddToAddress(address).AddValue("1").Build()
+	if err != nil {
+		t.Errorf("cannot create clause: %v", err)
+	}
+
+	tests := []struct {
+		body *LegacyBody
+		err  error
+	}{
+		{NewLegacy(nil).AddGasPrice(big.NewInt(1)).AddGasLimit(21000), utils.ErrClause},
+		{NewLegacy(cl).AddGasLimit(21000), utils.ErrGasPrice},
+		{NewLegacy(cl).AddGasPrice(big.NewInt(-1)).AddGasLimit(21000), utils.ErrGasPrice},
+		{NewLegacy(cl).AddGasPrice(big.NewInt(1)), utils.ErrGasLimit},
+		{NewLegacy(cl).AddGasPrice(big.NewInt(1)).AddGasLimit(21000).AddChainID(big.NewInt(-1)), utils.ErrChainID},
+	}
+
+	for _, test := range tests {
+		_, err := test.body.Build()
+		if err != test.err {
+			t.Errorf("got %v, wanted %v", err, test.err)
+		}
+	}
+}
+
+func TestLegacyContractCreation(t *testing.T) {
+	cl, err := clause.New().AddValue("0").AddData("0x6080604052").BuildContractCreation()
+	if err != nil {
+		t.Errorf("cannot create clause: %v", err)
+	}
+
+	tx, err := NewLegacy(cl).AddGasPrice(big.NewInt(1)).AddGasLimit(53000).Build()
+	if err != nil {
+		t.Errorf("cannot create transaction: %v", err)
+	}
+
+	// the recipient address of the contract creation is the empty string.
+	encoded := hex.EncodeToString(tx.RLP())
+	expected := "cd800182cf088080856080604052"
+	if encoded != expected {
+		t.Errorf("got %v, wanted %v", encoded, expected)
+	}
+}
@@ -0,0 +1,244 @@
+package transaction
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/mirzazhar/golang-transfer-clause/rlp"
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+// Transaction is implemented by the unsigned legacy, EIP-2930, EIP-1559 and
+// VeChainThor transactions, so that they can be signed by the Signer.
+type Transaction interface {
+	SigningHash() []byte
+	signedRLP(recoveryID byte, r, s *big.Int) ([]byte, error)
+}
+
+// Signer signs transactions with a secp256k1 private key.
+type Signer struct {
+	key *secp256k1.PrivateKey
+}
+
+// NewSigner creates an instance of Signer for the given raw private key of 32
+// bytes, hex-encoded with or without prefix 0x.
+func NewSigner(privateKey string) (*Signer, error) {
+	key, err := hex.DecodeString(strings.TrimPrefix(strings.TrimPrefix(privateKey, "0x"), "0X"))
+	if err != nil || len(key) != 32 {
+		return nil, utils.ErrPrivateKey
+	}
+
+	// the private key must be in the range [1, N-1].
+	var scalar secp256k1.ModNScalar
+	if overflow := scalar.SetByteSlice(key); overflow || scalar.IsZero() {
+		return nil, utils.ErrPrivateKey
+	}
+	return &Signer{key: secp256k1.NewPrivateKey(&scalar)}, nil
+}
+
+// GetAddress returns the EIP-55 checksummed address of the signer.
+func (s *Signer) GetAddress() string {
+	return publicKeyAddress(s.key.PubKey())
+}
+
+// Sign signs the given transaction and returns its signed raw transaction, as
+// accepted by eth_sendRawTransaction. It returns ErrTransactionType if the
+// transaction is nil, e.g. a nil *LegacyTransaction.
+func (s *Signer) Sign(tx Transaction) ([]byte, error) {
+	if tx == nil {
+		return nil, utils.ErrTransactionType
+	} else if value := reflect.ValueOf(tx); value.Kind() == reflect.Ptr && value.IsNil() {
+		return nil, utils.ErrTransactionType
+	}
+
+	recoveryID, r, sig, err := s.sign(tx.SigningHash())
+	if err != nil {
+		return nil, err
+	}
+	return tx.signedRLP(recoveryID, r, sig)
+}
+
+// SignHash signs the given hash and returns the signature of 65 bytes laid out
+// as r || s || v, where v is the recovery id; e.g. the signatures of the origin
+// and the delegator of the VeChainThor delegated transaction.
+func (s *Signer) SignHash(hash []byte) ([]byte, error) {
+	recoveryID, r, sig, err := s.sign(hash)
+	if err != nil {
+		return nil, err
+	}
+	return compactSignature(recoveryID, r, sig), nil
+}
+
+// sign signs the given hash and returns the recovery id and the signature.
+func (s *Signer) sign(hash []byte) (byte, *big.Int, *big.Int, error) {
+	if len(hash) != 32 {
+		return 0, nil, nil, utils.ErrSignature
+	}
+
+	// the compact signature is laid out as <27 + recovery code><r><s>, and
+	// its s is already canonical, i.e., in the lower half of the curve order.
+	signature := ecdsa.SignCompact(s.key, hash, false)
+	recoveryID := signature[0] - 27
+	if recoveryID > 1 {
+		return 0, nil, nil, utils.ErrSignature
+	}
+	return recoveryID, new(big.Int).SetBytes(signature[1:33]), new(big.Int).SetBytes(signature[33:65]), nil
+}
+
+// SignToHex signs the given transaction and returns its signed raw transaction
+// hex-encoded with prefix 0x.
+func (s *Signer) SignToHex(tx Transaction) (string, error) {
+	raw, err := s.Sign(tx)
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(raw), nil
+}
+
+// RecoverSender recovers the EIP-55 checksummed address of the sender of the
+// given signed raw transaction; either legacy, EIP-2930 or EIP-1559.
+func RecoverSender(raw []byte) (string, error) {
+	if len(raw) == 0 {
+		return "", fmt.Errorf("%w: empty transaction", utils.ErrRLP)
+	}
+
+	var hash []byte
+	var recoveryID uint64
+	var items [][]byte
+	var err error
+	if raw[0] >= 0xc0 {
+		// legacy transaction: [nonce, gasPrice, gasLimit, to, value, data, v, r, s].
+		if items, err = decodeFields(raw, 9); err != nil {
+			return "", err
+		}
+
+		v, err := rlp.DecodeBigInt(items[6])
+		if err != nil {
+			return "", err
+		}
+
+		unsigned := items[:6]
+		switch {
+		case v.Cmp(big.NewInt(27)) == 0 || v.Cmp(big.NewInt(28)) == 0:
+			recoveryID = v.Uint64() - 27
+		case v.Cmp(big.NewInt(35)) >= 0:
+			// v = chainId * 2 + 35 + recoveryID as defined by EIP-155.
+			chainID, parity := new(big.Int).DivMod(new(big.Int).Sub(v, big.NewInt(35)), big.NewInt(2), new(big.Int))
+			recoveryID = parity.Uint64()
+			unsigned = append(unsigned[:6:6], rlp.EncodeBigInt(chainID), rlp.EncodeUint(0), rlp.EncodeUint(0))
+		default:
+			return "", utils.ErrSignature
+		}
+		hash = utils.Keccak256(rlp.EncodeList(unsigned...))
+	} else {
+		var count int
+		switch raw[0] {
+		case AccessListTxType:
+			count = 11
+		case DynamicFeeTxType:
+			count = 12
+		default:
+			return "", fmt.Errorf("%w: %d", utils.ErrTransactionType, raw[0])
+		}
+
+		if items, err = decodeFields(raw[1:], count); err != nil {
+			return "", err
+		}
+
+		if recoveryID, err = rlp.DecodeUint(items[count-3]); err != nil {
+			return "", err
+		} else if recoveryID > 1 {
+			return "", utils.ErrSignature
+		}
+		hash = utils.Keccak256(typedEnvelope(raw[0], items[:count-3]))
+	}
+
+	r, err := rlp.DecodeBigInt(items[len(items)-2])
+	if err != nil {
+		return "", err
+	}
+
+	s, err := rlp.DecodeBigInt(items[len(items)-1])
+	if err != nil {
+		return "", err
+	}
+	return recoverAddress(hash, byte(recoveryID), r, s)
+}
+
+// RecoverSenderHex recovers the sender of the given signed raw transaction
+// hex-encoded with or without prefix 0x.
+func RecoverSenderHex(raw string) (string, error) {
+	data, err := hex.DecodeString(strings.TrimPrefix(strings.TrimPrefix(raw, "0x"), "0X"))
+	if err != nil {
+		return "", utils.ErrData
+	}
+	return RecoverSender(data)
+}
+
+// decodeFields decodes the RLP list of the given number of transaction fields.
+func decodeFields(data []byte, count int) ([][]byte, error) {
+	items, err := rlp.DecodeList(data)
+	if err != nil {
+		return nil, err
+	} else if len(items) != count {
+		return nil, fmt.Errorf("%w: got %d transaction fields, wanted %d", utils.ErrRLP, len(items), count)
+	}
+	return items, nil
+}
+
+// recoverAddress recovers the address of the key that signed the given hash.
+// As enforced since Homestead, s must be in the lower half of the curve order.
+func recoverAddress(hash []byte, recoveryID byte, r, s *big.Int) (string, error) {
+	n := secp256k1.Params().N
+	halfN := new(big.Int).Rsh(n, 1)
+	if r.Sign() <= 0 || r.Cmp(n) >= 0 || s.Sign() <= 0 || s.Cmp(halfN) > 0 {
+		return "", utils.ErrSignature
+	}
+
+	signature := make([]byte, 0, 65)
+	signature = append(signature, 27+recoveryID)
+	signature = append(signature, utils.LeftPadBytes(r.Bytes(), 32)...)
+	signature = append(signature, utils.LeftPadBytes(s.Bytes(), 32)...)
+
+	publicKey, _, err := ecdsa.RecoverCompact(signature, hash)
+	if err != nil {
+		return "", utils.ErrSignature
+	}
+	return publicKeyAddress(publicKey), nil
+}
+
+// publicKeyAddress returns the EIP-55 checksummed address of the given public
+// key, i.e., the last 20 bytes of the keccak-256 hash of its coordinates.
+func publicKeyAddress(publicKey *secp256k1.PublicKey) string {
+	address := utils.Keccak256(publicKey.SerializeUncompressed()[1:])[12:]
+	checksummed, _ := utils.ToChecksumAddress("0x" + hex.EncodeToString(address))
+	return checksummed
+}
+
+// compactSignature returns the signature laid out as r || s || v.
+func compactSignature(recoveryID byte, r, s *big.Int) []byte {
+	signature := make([]byte, 0, 65)
+	signature = append(signature, utils.LeftPadBytes(r.Bytes(), 32)...)
+	signature = append(signature, utils.LeftPadBytes(s.Bytes(), 32)...)
+	return append(signature, recoveryID)
+}
+
+// isValidCompactSignature validates the length and the recovery id of the
+// given signature laid out as r || s || v.
+func isValidCompactSignature(signature []byte) bool {
+	return len(signature) == 65 && signature[64] <= 1
+}
+
+// recoverCompact recovers the address of the key that signed the given hash
+// with the given signature laid out as r || s || v.
+func recoverCompact(hash, signature []byte) (string, error) {
+	if !isValidCompactSignature(signature) {
+		return "", utils.ErrSignature
+	}
+	return recoverAddress(hash, signature[64], new(big.Int).SetBytes(signature[:32]), new(big.Int).SetBytes(signature[32:64]))
+}
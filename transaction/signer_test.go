@@ -0,0 +1,202 @@
+package transaction
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/mirzazhar/golang-transfer-clause/clause"
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+// privateKey is the private key of the EIP-155 example.
+var privateKey string = "0x4646464646464646464646464646464646464646464646464646464646464646"
+
+func TestNewSigner(t *testing.T) {
+	signer, err := NewSigner(privateKey)
+	if err != nil {
+		t.Errorf("cannot create signer: %v", err)
+	}
+
+	expected := "0x9d8A62f656a8d1615C1294fd71e9CFb3E4855A4F"
+	if signer.GetAddress() != expected {
+		t.Errorf("got %v, wanted %v", signer.GetAddress(), expected)
+	}
+
+	invalid := []string{
+		"",
+		"0x46",
+		"0xzz46464646464646464646464646464646464646464646464646464646464646",
+		"0x0000000000000000000000000000000000000000000000000000000000000000",
+		"0xfffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141",
+	}
+	for _, key := range invalid {
+		if _, err := NewSigner(key); err != utils.ErrPrivateKey {
+			t.Errorf("got %v, wanted %v", err, utils.ErrPrivateKey)
+		}
+	}
+}
+
+func TestSignNil(t *testing.T) {
+	signer, err := NewSigner(privateKey)
+	if err != nil {
+		t.Errorf("cannot create signer: %v", err)
+	}
+
+	var legacy *LegacyTransaction
+	var dynamicFee *DynamicFeeTransaction
+	for _, tx := range []Transaction{nil, legacy, dynamicFee} {
+		if _, err := signer.Sign(tx); err != utils.ErrTransactionType {
+			t.Errorf("got %v, wanted %v", err, utils.ErrTransactionType)
+		}
+	}
+}
+
+func TestSignLegacy(t *testing.T) {
+	signer, err := NewSigner(privateKey)
+	if err != nil {
+		t.Errorf("cannot create signer: %v", err)
+	}
+
+	tests := []struct {
+		chainID  *big.Int
+		expected string
+	}{
+		// the signed transaction of the EIP-155 example.
+		{big.NewInt(1), "0xf86c098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a76400008025a028ef61340bd939bc2195fe537567866003e1a15d3c71ff63e1590620aa636276a067cbe9d8997f761aecb703304b3800ccf555c9f3dc64214b297fb1966a3b6d83"},
+		{nil, "0xf86c098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a7640000801ba08383adc8b8ae116f918fb44ca7ff9dfd8012596a5c130c6246a2cc717ba41cdaa053ddfacf5bd4aa7e46d1575acf52636ea659b91f29e2fb91c75567a279738f38"},
+	}
+
+	for _, test := range tests {
+		tx, err := createLegacyTransaction(test.chainID)
+		if err != nil {
+			t.Errorf("cannot create transaction: %v", err)
+		}
+
+		raw, err := signer.SignToHex(tx)
+		if err != nil {
+			t.Errorf("cannot sign transaction: %v", err)
+		}
+
+		if raw != test.expected {
+			t.Errorf("got %v, wanted %v", raw, test.expected)
+		}
+
+		sender, err := RecoverSenderHex(raw)
+		if err != nil {
+			t.Errorf("cannot recover sender: %v", err)
+		}
+
+		if sender != signer.GetAddress() {
+			t.Errorf("got %v, wanted %v", sender, signer.GetAddress())
+		}
+	}
+}
+
+func TestSignTyped(t *testing.T) {
+	signer, err := NewSigner(privateKey)
+	if err != nil {
+		t.Errorf("cannot create signer: %v", err)
+	}
+
+	accessListTx, err := createAccessListTransaction()
+	if err != nil {
+		t.Errorf("cannot create transaction: %v", err)
+	}
+
+	test := dynamicFeeTests[0]
+	cl, err := clause.New().AddToAddress(test.to).AddValue(test.value).AddUnit(clause.Wei).AddData(test.data).Build()
+	if err != nil {
+		t.Errorf("cannot create clause: %v", err)
+	}
+
+	dynamicFeeTx, err := NewDynamicFee(cl).
+		AddChainID(big.NewInt(1)).
+		AddNonce(test.nonce).
+		AddMaxPriorityFeePerGas(big.NewInt(test.tip)).
+		AddMaxFeePerGas(big.NewInt(test.maxFee)).
+		AddGasLimit(test.gasLimit).
+		AddAccessList(AccessList{{Address: test.to, StorageKeys: []string{"0x0000000000000000000000000000000000000000000000000000000000000001"}}}).
+		Build()
+	if err != nil {
+		t.Errorf("cannot create transaction: %v", err)
+	}
+
+	tests := []struct {
+		tx       Transaction
+		expected string
+	}{
+		{accessListTx, "0x01f87101830a6d8e850a6d3076f28307a12094b05178ed26b624875de845e07a8eb612d14097e1872ec391d29f000080c001a039629d9cf9d742206b14462bc4eabbae124911c4ef56f35d0239c80825532b46a00e46833aeec4d0588453c77e8e077a3dd075414c65966be4c4dd84a7c8c12440"},
+		{dynamicFeeTx, "0x02f8ea012484773594008502b96b6cdb8301117094dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb000000000000000000000000cf3aa1a77fa8c221f80bd15f4d7a36186eeb7df10000000000000000000000000000000000000000000000000000000007270e00f838f794dac17f958d2ee523a2206206994597c13d831ec7e1a0000000000000000000000000000000000000000000000000000000000000000180a0c42d1d3852da5a066aaac37912c4fa9c9ca3b47d44514e5a53cfa8568ba12de8a026987283ac55c29f327987a24d57a3e169b6462b4f3a09b3f207452b20884159"},
+	}
+
+	for _, test := range tests {
+		raw, err := signer.SignToHex(test.tx)
+		if err != nil {
+			t.Errorf("cannot sign transaction: %v", err)
+		}
+
+		if raw != test.expected {
+			t.Errorf("got %v, wanted %v", raw, test.expected)
+		}
+
+		sender, err := RecoverSenderHex(raw)
+		if err != nil {
+			t.Errorf("cannot recover sender: %v", err)
+		}
+
+		if sender != signer.GetAddress() {
+			t.Errorf("got %v, wanted %v", sender, signer.GetAddress())
+		}
+	}
+}
+
+func TestRecoverSenderMainnet(t *testing.T) {
+	tests := map[string]string{
+		// legacy transaction of the block 18189758.
+		"0xf86b028502125f613d825208943e180d55386f7fe1441c0e0d7b1b79b768eef31f871550f7dca700008025a094572925a303a4831e4fef20210cafe26266fb97688ac02e5a9c8a70b4966fd9a01cb943314ccb59045804c15500f57e04e3875228a63cbd548cde6369d66a0793": "0x7AE463AA85A3aC3Bb05a4862b20b8f7353aA03A3",
+		// EIP-2930 transaction of the block 19431837.
+		"0x01f87101830a6d8e850a6d3076f28307a12094b05178ed26b624875de845e07a8eb612d14097e1872ec391d29f000080c080a0ca6269197e71623f391827c2020871d611e341f74c12ee1d13e274348cf8c996a0189ff08439030629c395d59fbaaa626b4fdc37744b94a30f6773ed3e4a31420f": "0x264bd8291fAE1D75DB2c5F573b07faA6715997B5",
+		// EIP-1559 transactions of the blocks 18189758 and 19431837.
+		"0x" + dynamicFeeTests[0].raw: "0x558f539D759935483775492fE2c01D724e7CC03f",
+		"0x" + dynamicFeeTests[1].raw: "0xc08F6cE45705d0c8FA3c3Cb53f64664177bEAEF9",
+		"0x" + dynamicFeeTests[2].raw: "0xae2Fc483527B8EF99EB5D9B44875F005ba1FaE13",
+	}
+
+	for raw, expected := range tests {
+		sender, err := RecoverSenderHex(raw)
+		if err != nil {
+			t.Errorf("cannot recover sender: %v", err)
+		}
+
+		if sender != expected {
+			t.Errorf("got %v, wanted %v", sender, expected)
+		}
+
+		if !utils.IsValidAddress(sender) {
+			t.Errorf("got %v, wanted a valid address", sender)
+		}
+	}
+}
+
+func TestRecoverSenderInvalid(t *testing.T) {
+	tests := []struct {
+		raw string
+		err error
+	}{
+		{"0xzz", utils.ErrData},
+		{"0x", utils.ErrRLP},
+		{"0x03c0", utils.ErrTransactionType},
+		{"0x02c0", utils.ErrRLP},
+		// the legacy transaction of the EIP-155 example with v = 26.
+		{"0xf86c098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a7640000801aa028ef61340bd939bc2195fe537567866003e1a15d3c71ff63e1590620aa636276a067cbe9d8997f761aecb703304b3800ccf555c9f3dc64214b297fb1966a3b6d83", utils.ErrSignature},
+		// the legacy transaction of the EIP-155 example with s = 0.
+		{"0xf84c098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a76400008025a028ef61340bd939bc2195fe537567866003e1a15d3c71ff63e1590620aa63627680", utils.ErrSignature},
+	}
+
+	for _, test := range tests {
+		if _, err := RecoverSenderHex(test.raw); !errors.Is(err, test.err) {
+			t.Errorf("got %v, wanted %v", err, test.err)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package transaction
+
+import (
+	"math/big"
+
+	"github.com/mirzazhar/golang-transfer-clause/clause"
+	"github.com/mirzazhar/golang-transfer-clause/rlp"
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+// clauseFields returns the recipient address, the value in wei and the data of
+// the given clause as used by the transaction encoding. The recipient address
+// of the contract creation clause is empty.
+func clauseFields(cl *clause.Clause) ([]byte, *big.Int, []byte, error) {
+	if cl == nil {
+		return nil, nil, nil, utils.ErrClause
+	}
+
+	var to []byte
+	if !cl.IsContractCreation() {
+		var err error
+		if to, err = utils.AddresstoBytes(cl.GetToAddress()); err != nil {
+			return nil, nil, nil, utils.ErrToAddress
+		}
+	}
+
+	value := cl.GetWei()
+	if value == nil {
+		return nil, nil, nil, utils.ErrValue
+	}
+
+	data, err := cl.GetDataBytes()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return to, value, data, nil
+}
+
+// isValidAmount validates the given gas price, fee or chain id; it must be
+// non-nil and must not be a negative number.
+func isValidAmount(amount *big.Int) bool {
+	return amount != nil && amount.Sign() >= 0
+}
+
+// typedEnvelope returns the EIP-2718 envelope of the given transaction type
+// and RLP-encoded fields: type || rlp(fields).
+func typedEnvelope(txType byte, fields [][]byte) []byte {
+	return append([]byte{txType}, rlp.EncodeList(fields...)...)
+}
@@ -0,0 +1,31 @@
+package transaction
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mirzazhar/golang-transfer-clause/clause"
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+func TestClauseFields(t *testing.T) {
+	cl, err := clause.New().AddToAddress(address).AddValue("2").AddUnit(clause.Wei).AddData("0xcafe").Build()
+	if err != nil {
+		t.Errorf("cannot create clause: %v", err)
+	}
+
+	to, value, data, err := clauseFields(cl)
+	if err != nil {
+		t.Errorf("cannot get clause fields: %v", err)
+	}
+
+	expectedto, _ := utils.AddresstoBytes(address)
+	if !reflect.DeepEqual(to, expectedto) || value.Int64() != 2 || !reflect.DeepEqual(data, []byte{0xca, 0xfe}) {
+		t.Errorf("got %v %v %v, wanted %v %v %v", to, value, data, expectedto, 2, []byte{0xca, 0xfe})
+	}
+
+	cl.AddData("coffee")
+	if _, _, _, err := clauseFields(cl); err != utils.ErrData {
+		t.Errorf("got %v, wanted %v", err, utils.ErrData)
+	}
+}
@@ -0,0 +1,304 @@
+package transaction
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+
+	"github.com/mirzazhar/golang-transfer-clause/clause"
+	"github.com/mirzazhar/golang-transfer-clause/rlp"
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+// Chain tags of the VeChainThor networks, i.e., the last byte of their genesis
+// block id.
+const (
+	VeChainMainnetChainTag = 0x4a
+	VeChainTestnetChainTag = 0x27
+)
+
+// VeChainDelegatedFeature is the bit of the reserved features that marks the
+// transaction as delegated, i.e., its gas is paid by the delegator (VIP-191).
+const VeChainDelegatedFeature = 1
+
+// VeChainBody holds the necessary information to create a VeChainThor
+// transaction out of several clauses: the chain tag, block reference,
+// expiration, gas price coefficient, gas, dependency and nonce.
+type VeChainBody struct {
+	clauses      []*clause.Clause
+	chainTag     byte
+	blockRef     uint64
+	expiration   uint32
+	gasPriceCoef uint8
+	gas          uint64
+	dependsOn    string
+	nonce        uint64
+	delegated    bool
+}
+
+// NewVeChain creates and returns an instance of VeChainBody for the given
+// clauses, which are executed in order within the same transaction.
+func NewVeChain(clauses ...*clause.Clause) *VeChainBody {
+	return &VeChainBody{clauses: clauses}
+}
+
+// AddClause appends the given clause to the transaction.
+func (vb *VeChainBody) AddClause(cl *clause.Clause) *VeChainBody {
+	vb.clauses = append(vb.clauses, cl)
+	return vb
+}
+
+// AddChainTag adds the chain tag of the network the transaction is valid on.
+func (vb *VeChainBody) AddChainTag(chainTag byte) *VeChainBody {
+	vb.chainTag = chainTag
+	return vb
+}
+
+// AddBlockRef adds the reference to the block the transaction becomes valid
+// at, i.e., the first 8 bytes of the block id.
+func (vb *VeChainBody) AddBlockRef(blockRef uint64) *VeChainBody {
+	vb.blockRef = blockRef
+	return vb
+}
+
+// AddExpiration adds the number of blocks after the block reference the
+// transaction stays valid for.
+func (vb *VeChainBody) AddExpiration(expiration uint32) *VeChainBody {
+	vb.expiration = expiration
+	return vb
+}
+
+// AddGasPriceCoef adds the coefficient that raises the gas price above the
+// base gas price; the gas price is base * (1 + coef / 255).
+func (vb *VeChainBody) AddGasPriceCoef(gasPriceCoef uint8) *VeChainBody {
+	vb.gasPriceCoef = gasPriceCoef
+	return vb
+}
+
+// AddGas adds the maximum amount of gas the transaction may consume.
+func (vb *VeChainBody) AddGas(gas uint64) *VeChainBody {
+	vb.gas = gas
+	return vb
+}
+
+// AddDependsOn adds the id of the transaction that must be executed
+// successfully before this transaction. It is optional.
+func (vb *VeChainBody) AddDependsOn(txID string) *VeChainBody {
+	vb.dependsOn = txID
+	return vb
+}
+
+// AddNonce adds the nonce chosen by the sender; unlike ethereum, it does not
+// need to be sequential.
+func (vb *VeChainBody) AddNonce(nonce uint64) *VeChainBody {
+	vb.nonce = nonce
+	return vb
+}
+
+// AddDelegation marks the transaction as delegated as defined by VIP-191, so
+// that its gas is paid by the delegator, who signs it along with the origin.
+func (vb *VeChainBody) AddDelegation(delegated bool) *VeChainBody {
+	vb.delegated = delegated
+	return vb
+}
+
+// Build validates its underlying instance and then creates the new instance
+// of VeChainTransaction.
+func (vb *VeChainBody) Build() (*VeChainTransaction, error) {
+	if len(vb.clauses) == 0 {
+		return nil, utils.ErrClause
+	}
+
+	clauses := make([][]byte, len(vb.clauses))
+	for i, cl := range vb.clauses {
+		to, value, data, err := clauseFields(cl)
+		if err != nil {
+			return nil, err
+		}
+		clauses[i] = rlp.EncodeList(rlp.EncodeBytes(to), rlp.EncodeBigInt(value), rlp.EncodeBytes(data))
+	}
+
+	if vb.gas == 0 {
+		return nil, utils.ErrGasLimit
+	}
+
+	var dependsOn []byte
+	if vb.dependsOn != "" {
+		var err error
+		dependsOn, err = hex.DecodeString(strings.TrimPrefix(strings.TrimPrefix(vb.dependsOn, "0x"), "0X"))
+		if err != nil || len(dependsOn) != 32 {
+			return nil, utils.ErrDependsOn
+		}
+	}
+
+	body := *vb
+	body.clauses = append([]*clause.Clause(nil), vb.clauses...)
+	return &VeChainTransaction{
+		VeChainBody:    body,
+		encodedClauses: clauses,
+		dependsOnID:    dependsOn,
+	}, nil
+}
+
+// VeChainTransaction represents the unsigned VeChainThor transaction of
+// several clauses.
+type VeChainTransaction struct {
+	VeChainBody
+	encodedClauses [][]byte
+	dependsOnID    []byte
+}
+
+// GetClauses returns the clauses of the transaction.
+func (tx *VeChainTransaction) GetClauses() []*clause.Clause {
+	return tx.clauses
+}
+
+// GetChainTag returns the chain tag of the transaction.
+func (tx *VeChainTransaction) GetChainTag() byte {
+	return tx.chainTag
+}
+
+// IsDelegated reports whether the gas of the transaction is paid by a delegator.
+func (tx *VeChainTransaction) IsDelegated() bool {
+	return tx.delegated
+}
+
+// reserved returns the RLP encoding of the reserved field; the list of the
+// features, or the empty list without any feature.
+func (tx *VeChainTransaction) reserved() []byte {
+	if !tx.delegated {
+		return rlp.EncodeList()
+	}
+	return rlp.EncodeList(rlp.EncodeUint(VeChainDelegatedFeature))
+}
+
+// fields returns the RLP-encoded fields of the unsigned transaction.
+func (tx *VeChainTransaction) fields() [][]byte {
+	return [][]byte{
+		rlp.EncodeUint(uint64(tx.chainTag)),
+		rlp.EncodeUint(tx.blockRef),
+		rlp.EncodeUint(uint64(tx.expiration)),
+		rlp.EncodeList(tx.encodedClauses...),
+		rlp.EncodeUint(uint64(tx.gasPriceCoef)),
+		rlp.EncodeUint(tx.gas),
+		rlp.EncodeBytes(tx.dependsOnID),
+		rlp.EncodeUint(tx.nonce),
+		tx.reserved(),
+	}
+}
+
+// RLP returns the RLP encoding of the unsigned transaction: rlp([chainTag,
+// blockRef, expiration, clauses, gasPriceCoef, gas, dependsOn, nonce, reserved]).
+func (tx *VeChainTransaction) RLP() []byte {
+	return rlp.EncodeList(tx.fields()...)
+}
+
+// SigningHash returns the blake2b-256 hash of the RLP encoding of the unsigned
+// transaction.
+func (tx *VeChainTransaction) SigningHash() []byte {
+	return utils.Blake2b256(tx.RLP())
+}
+
+// ID returns the id of the transaction sent by the given origin, i.e., the
+// blake2b-256 hash of the signing hash and the origin address.
+func (tx *VeChainTransaction) ID(origin string) ([]byte, error) {
+	return tx.DelegatorSigningHash(origin)
+}
+
+// DelegatorSigningHash returns the hash to be signed by the delegator of the
+// transaction sent by the given origin. It binds the origin address, so the
+// signature of the delegator cannot be reused for any other origin.
+func (tx *VeChainTransaction) DelegatorSigningHash(origin string) ([]byte, error) {
+	address, err := utils.AddresstoBytes(origin)
+	if err != nil {
+		return nil, err
+	}
+	return utils.Blake2b256(tx.SigningHash(), address), nil
+}
+
+// signedRLP returns the RLP encoding of the transaction signed with the given
+// recovery id and signature, which is appended to the fields as the 65 bytes
+// r || s || v. The delegated transaction needs the signature of the delegator
+// as well, so it must be assembled by AddSignatures instead.
+func (tx *VeChainTransaction) signedRLP(recoveryID byte, r, s *big.Int) ([]byte, error) {
+	if tx.IsDelegated() {
+		return nil, utils.ErrDelegation
+	}
+	return rlp.EncodeList(append(tx.fields(), rlp.EncodeBytes(compactSignature(recoveryID, r, s)))...), nil
+}
+
+// AddSignatures assembles the signed delegated transaction out of the separate
+// signatures of the origin and the delegator, each of 65 bytes r || s || v;
+// the origin signs SigningHash and the delegator signs DelegatorSigningHash.
+// It returns the RLP encoding of the transaction with the combined signature
+// of 130 bytes.
+func (tx *VeChainTransaction) AddSignatures(originSignature, delegatorSignature []byte) ([]byte, error) {
+	if !tx.IsDelegated() {
+		return nil, utils.ErrDelegation
+	} else if !isValidCompactSignature(originSignature) || !isValidCompactSignature(delegatorSignature) {
+		return nil, utils.ErrSignature
+	}
+
+	signature := append(append([]byte{}, originSignature...), delegatorSignature...)
+	return rlp.EncodeList(append(tx.fields(), rlp.EncodeBytes(signature))...), nil
+}
+
+// RecoverVeChainOrigin recovers the EIP-55 checksummed address of the origin
+// of the given signed raw VeChainThor transaction.
+func RecoverVeChainOrigin(raw []byte) (string, error) {
+	hash, _, signature, err := decodeVeChain(raw)
+	if err != nil {
+		return "", err
+	}
+	return recoverCompact(hash, signature[:65])
+}
+
+// RecoverVeChainDelegator recovers the EIP-55 checksummed address of the
+// delegator, i.e., the gas payer, of the given signed raw delegated
+// VeChainThor transaction.
+func RecoverVeChainDelegator(raw []byte) (string, error) {
+	hash, delegated, signature, err := decodeVeChain(raw)
+	if err != nil {
+		return "", err
+	} else if !delegated {
+		return "", utils.ErrDelegation
+	}
+
+	origin, err := recoverCompact(hash, signature[:65])
+	if err != nil {
+		return "", err
+	}
+
+	address, _ := utils.AddresstoBytes(origin)
+	return recoverCompact(utils.Blake2b256(hash, address), signature[65:])
+}
+
+// decodeVeChain decodes the given signed raw VeChainThor transaction and
+// returns its signing hash, whether it is delegated and its signature.
+func decodeVeChain(raw []byte) ([]byte, bool, []byte, error) {
+	items, err := decodeFields(raw, 10)
+	if err != nil {
+		return nil, false, nil, err
+	}
+
+	reserved, err := rlp.DecodeList(items[8])
+	if err != nil {
+		return nil, false, nil, err
+	}
+
+	var features uint64
+	if len(reserved) > 0 {
+		if features, err = rlp.DecodeUint(reserved[0]); err != nil {
+			return nil, false, nil, err
+		}
+	}
+	delegated := features&VeChainDelegatedFeature != 0
+
+	signature, err := rlp.DecodeBytes(items[9])
+	if err != nil {
+		return nil, false, nil, err
+	} else if (delegated && len(signature) != 130) || (!delegated && len(signature) != 65) {
+		return nil, false, nil, utils.ErrSignature
+	}
+	return utils.Blake2b256(rlp.EncodeList(items[:9]...)), delegated, signature, nil
+}
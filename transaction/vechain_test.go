@@ -0,0 +1,259 @@
+package transaction
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/mirzazhar/golang-transfer-clause/clause"
+	"github.com/mirzazhar/golang-transfer-clause/utils"
+)
+
+// veChainKey is the private key of the VeChainThor transaction test.
+var veChainKey string = "7582be841ca040aa940fff6c05773129e135623e41acce3e0b8ba520dc1ae26a"
+
+// createVeChainTransaction creates the transaction of the VeChainThor
+// transaction test; two clauses of 10000 and 20000 wei.
+func createVeChainTransaction() (*VeChainTransaction, error) {
+	var clauses []*clause.Clause
+	for _, value := range []string{"10000", "20000"} {
+		cl, err := clause.New().
+			AddToAddress("0x7567d83b7b8d80addcb281a71d54fc7b3364ffed").
+			AddValue(value).
+			AddUnit(clause.Wei).
+			AddData("0x000000606060").
+			Build()
+		if err != nil {
+			return nil, err
+		}
+		clauses = append(clauses, cl)
+	}
+
+	return NewVeChain(clauses...).
+		AddChainTag(1).
+		AddBlockRef(0xaabbccdd).
+		AddExpiration(32).
+		AddGasPriceCoef(128).
+		AddGas(21000).
+		AddNonce(12345678).
+		Build()
+}
+
+func TestVeChainSigningHash(t *testing.T) {
+	tx, err := createVeChainTransaction()
+	if err != nil {
+		t.Errorf("cannot create transaction: %v", err)
+	}
+
+	hash := hex.EncodeToString(tx.SigningHash())
+	expected := "2a1c25ce0d66f45276a5f308b99bf410e2fc7d5b6ea37a49f2ab9f1da9446478"
+	if hash != expected {
+		t.Errorf("got %v, wanted %v", hash, expected)
+	}
+
+	if len(tx.GetClauses()) != 2 {
+		t.Errorf("got %v, wanted %v", len(tx.GetClauses()), 2)
+	}
+}
+
+func TestVeChainSign(t *testing.T) {
+	tx, err := createVeChainTransaction()
+	if err != nil {
+		t.Errorf("cannot create transaction: %v", err)
+	}
+
+	signer, err := NewSigner(veChainKey)
+	if err != nil {
+		t.Errorf("cannot create signer: %v", err)
+	}
+
+	raw, err := signer.Sign(tx)
+	if err != nil {
+		t.Errorf("cannot sign transaction: %v", err)
+	}
+
+	expected := "f8970184aabbccdd20f840df947567d83b7b8d80addcb281a71d54fc7b3364ffed82271086000000606060df947567d83b7b8d80addcb281a71d54fc7b3364ffed824e208600000060606081808252088083bc614ec0b841f76f3c91a834165872aa9464fc55b03a13f46ea8d3b858e528fcceaf371ad6884193c3f313ff8effbb57fe4d1adc13dceb933bedbf9dbb528d2936203d5511df00"
+	if hex.EncodeToString(raw) != expected {
+		t.Errorf("got %x, wanted %v", raw, expected)
+	}
+
+	origin, err := RecoverVeChainOrigin(raw)
+	if err != nil {
+		t.Errorf("cannot recover origin: %v", err)
+	}
+
+	expected = "0xd989829d88B0eD1B06eDF5C50174eCfA64F14A64"
+	if origin != expected || origin != signer.GetAddress() {
+		t.Errorf("got %v, wanted %v", origin, expected)
+	}
+
+	id, err := tx.ID(origin)
+	if err != nil {
+		t.Errorf("cannot compute id: %v", err)
+	}
+
+	expected = "da90eaea52980bc4bb8d40cb2ff84d78433b3b4a6e7d50b75736c5e3e77b71ec"
+	if hex.EncodeToString(id) != expected {
+		t.Errorf("got %x, wanted %v", id, expected)
+	}
+
+	// the error of the origin address is reported as it is.
+	for _, invalid := range []string{"0x3", "0xzz89829d88B0eD1B06eDF5C50174eCfA64F14A64"} {
+		_, wanted := utils.AddresstoBytes(invalid)
+		if _, err := tx.ID(invalid); err != wanted {
+			t.Errorf("got %v, wanted %v", err, wanted)
+		}
+	}
+}
+
+func TestVeChainInvalid(t *testing.T) {
+	cl, err := clause.New().AddToAddress(address).AddValue("1").Build()
+	if err != nil {
+		t.Errorf("cannot create clause: %v", err)
+	}
+
+	tests := []struct {
+		body *VeChainBody
+		err  error
+	}{
+		{NewVeChain().AddGas(21000), utils.ErrClause},
+		{NewVeChain(cl, nil).AddGas(21000), utils.ErrClause},
+		{NewVeChain(cl), utils.ErrGasLimit},
+		{NewVeChain(cl).AddGas(21000).AddDependsOn("0x01"), utils.ErrDependsOn},
+	}
+
+	for _, test := range tests {
+		_, err := test.body.Build()
+		if err != test.err {
+			t.Errorf("got %v, wanted %v", err, test.err)
+		}
+	}
+
+	if _, err := RecoverVeChainOrigin([]byte{0xc0}); !errors.Is(err, utils.ErrRLP) {
+		t.Errorf("got %v, wanted %v", err, utils.ErrRLP)
+	}
+}
+
+// delegatorKey is the private key of the delegator of the VeChainThor
+// delegated transaction test.
+var delegatorKey string = "321d6443bc6177273b5abf54210fe806d451d6b7973bccc2384ef78bbcd0bf51"
+
+func TestVeChainDelegation(t *testing.T) {
+	cl, err := clause.New().
+		AddToAddress("0x7567d83b7b8d80addcb281a71d54fc7b3364ffed").
+		AddValue("10000").
+		AddUnit(clause.Wei).
+		AddData("0x000000606060").
+		Build()
+	if err != nil {
+		t.Errorf("cannot create clause: %v", err)
+	}
+
+	cl2, err := clause.New().
+		AddToAddress("0x7567d83b7b8d80addcb281a71d54fc7b3364ffed").
+		AddValue("20000").
+		AddUnit(clause.Wei).
+		AddData("0x000000606060").
+		Build()
+	if err != nil {
+		t.Errorf("cannot create clause: %v", err)
+	}
+
+	tx, err := NewVeChain(cl, cl2).
+		AddChainTag(0xa4).
+		AddBlockRef(0xaabbccdd).
+		AddExpiration(32).
+		AddGasPriceCoef(128).
+		AddGas(21000).
+		AddNonce(12345678).
+		AddDelegation(true).
+		Build()
+	if err != nil {
+		t.Errorf("cannot create transaction: %v", err)
+	}
+
+	if !tx.IsDelegated() {
+		t.Errorf("got %v, wanted %v", tx.IsDelegated(), true)
+	}
+
+	hash := hex.EncodeToString(tx.SigningHash())
+	expected := "ba6c0c0b328f3db72efd57587fbe39d56490523447e0fe211e6a939dca4e9ace"
+	if hash != expected {
+		t.Errorf("got %v, wanted %v", hash, expected)
+	}
+
+	// the origin and the delegator sign separately.
+	origin, _ := NewSigner(veChainKey)
+	delegator, _ := NewSigner(delegatorKey)
+
+	originSignature, err := origin.SignHash(tx.SigningHash())
+	if err != nil {
+		t.Errorf("cannot sign transaction: %v", err)
+	}
+
+	delegatorHash, err := tx.DelegatorSigningHash(origin.GetAddress())
+	if err != nil {
+		t.Errorf("cannot compute delegator signing hash: %v", err)
+	}
+
+	expected = "b7570fe3fa550de27e360d66e077289201b886f057967a5213ec116abeab6bff"
+	if hex.EncodeToString(delegatorHash) != expected {
+		t.Errorf("got %x, wanted %v", delegatorHash, expected)
+	}
+
+	delegatorSignature, err := delegator.SignHash(delegatorHash)
+	if err != nil {
+		t.Errorf("cannot sign transaction: %v", err)
+	}
+
+	raw, err := tx.AddSignatures(originSignature, delegatorSignature)
+	if err != nil {
+		t.Errorf("cannot assemble transaction: %v", err)
+	}
+
+	expected = "f8da81a484aabbccdd20f840df947567d83b7b8d80addcb281a71d54fc7b3364ffed82271086000000606060df947567d83b7b8d80addcb281a71d54fc7b3364ffed824e208600000060606081808252088083bc614ec101b882ab1c59cae3be904074633e54353ae7da76359cf75e22bbcd0b202b633179f1662482bfe5a379b15a7cf1f0fe5c614154cf10c5dc39cc95e7e6adcc8eb1dfe3c900ff7b1b43f892f7a9f7be703d08a178152926b6b385a6161849701f4ab1c4cce47ad6cc405cff25e0b050bbc8aec887b8773483f2fd20e7ab1d6fec814f2c8b9e01"
+	if hex.EncodeToString(raw) != expected {
+		t.Errorf("got %x, wanted %v", raw, expected)
+	}
+
+	recovered, err := RecoverVeChainOrigin(raw)
+	if err != nil || recovered != origin.GetAddress() {
+		t.Errorf("got %v, wanted %v", recovered, origin.GetAddress())
+	}
+
+	recovered, err = RecoverVeChainDelegator(raw)
+	if err != nil || recovered != delegator.GetAddress() {
+		t.Errorf("got %v, wanted %v", recovered, delegator.GetAddress())
+	}
+
+	// the delegated transaction cannot be signed by the origin alone.
+	if _, err := origin.Sign(tx); err != utils.ErrDelegation {
+		t.Errorf("got %v, wanted %v", err, utils.ErrDelegation)
+	}
+
+	if _, err := tx.AddSignatures(originSignature, delegatorSignature[:64]); err != utils.ErrSignature {
+		t.Errorf("got %v, wanted %v", err, utils.ErrSignature)
+	}
+}
+
+func TestVeChainWithoutDelegation(t *testing.T) {
+	tx, err := createVeChainTransaction()
+	if err != nil {
+		t.Errorf("cannot create transaction: %v", err)
+	}
+
+	signer, _ := NewSigner(veChainKey)
+	signature, err := signer.SignHash(tx.SigningHash())
+	if err != nil {
+		t.Errorf("cannot sign transaction: %v", err)
+	}
+
+	if _, err := tx.AddSignatures(signature, signature); err != utils.ErrDelegation {
+		t.Errorf("got %v, wanted %v", err, utils.ErrDelegation)
+	}
+
+	raw, _ := signer.Sign(tx)
+	if _, err := RecoverVeChainDelegator(raw); err != utils.ErrDelegation {
+		t.Errorf("got %v, wanted %v", err, utils.ErrDelegation)
+	}
+}
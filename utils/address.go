@@ -0,0 +1,98 @@
+package utils
+
+import (
+	"encoding/hex"
+)
+
+// Address represents the Ethereum-based account address of 20 bytes. Unlike
+// the address given as a string, it is validated once when parsed, and the
+// addresses compare equal regardless of the case they were written in.
+type Address [20]byte
+
+// ParseAddress parses the given address hex-encoded with prefix 0x in any case.
+// It returns ErrAddress if the address format is invalid.
+func ParseAddress(address string) (Address, error) {
+	var a Address
+	if len(address) != 42 || !IsValidAddress(address) {
+		return a, ErrAddress
+	}
+
+	hex.Decode(a[:], []byte(address[2:]))
+	return a, nil
+}
+
+// ParseChecksumAddress parses the given address like ParseAddress, but it
+// returns ErrAddressChecksum as well if the mixed-case address does not match
+// its EIP-55 checksum.
+func ParseChecksumAddress(address string) (Address, error) {
+	a, err := ParseAddress(address)
+	if err != nil {
+		return a, err
+	} else if !IsValidChecksumAddress(address) {
+		return a, ErrAddressChecksum
+	}
+	return a, nil
+}
+
+// BytesToAddress converts the given bytes into Address. If the bytes are longer
+// than 20 bytes, only the last 20 bytes are kept; if shorter, they are left
+// padded with zeros.
+func BytesToAddress(data []byte) Address {
+	var a Address
+	if len(data) > len(a) {
+		data = data[len(data)-len(a):]
+	}
+	copy(a[len(a)-len(data):], data)
+	return a
+}
+
+// Bytes returns the address as a byte slice of 20 bytes.
+func (a Address) Bytes() []byte {
+	return a[:]
+}
+
+// String returns the EIP-55 mixed-case checksum encoding of the address.
+func (a Address) String() string {
+	checksum, _ := ToChecksumAddress("0x" + hex.EncodeToString(a[:]))
+	return checksum
+}
+
+// IsZero reports whether the address is the zero address.
+func (a Address) IsZero() bool {
+	return a == Address{}
+}
+
+// Equal reports whether the address equals the given address.
+func (a Address) Equal(other Address) bool {
+	return a == other
+}
+
+// MarshalText encodes the address into its EIP-55 checksum encoding, so that
+// it is encoded as a JSON string.
+func (a Address) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalText decodes the given address hex-encoded with prefix 0x in any
+// case.
+func (a *Address) UnmarshalText(text []byte) error {
+	address, err := ParseAddress(string(text))
+	if err != nil {
+		return err
+	}
+
+	*a = address
+	return nil
+}
+
+// EqualAddresses reports whether the given addresses hex-encoded with prefix
+// 0x are the same account regardless of their case. Any invalid address is
+// compared as it is.
+func EqualAddresses(a, b string) bool {
+	x, errX := ParseAddress(a)
+	y, errY := ParseAddress(b)
+	if errX != nil || errY != nil {
+		return a == b
+	}
+	return x.Equal(y)
+}
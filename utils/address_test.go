@@ -0,0 +1,94 @@
+package utils
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseAddress(t *testing.T) {
+	for _, address := range correctformataddress {
+		parsed, err := ParseAddress(address)
+		if err != nil {
+			t.Errorf("got %v, wanted %v", err, nil)
+		}
+		if got := parsed.Bytes(); string(got) != string(addressbytes) {
+			t.Errorf("got %v, wanted %v", got, addressbytes)
+		}
+	}
+
+	for _, address := range append(wrongformataddress, "x", "0") {
+		if _, err := ParseAddress(address); err != ErrAddress {
+			t.Errorf("got %v, wanted %v", err, ErrAddress)
+		}
+	}
+}
+
+func TestParseChecksumAddress(t *testing.T) {
+	if _, err := ParseChecksumAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"); err != nil {
+		t.Errorf("got %v, wanted %v", err, nil)
+	}
+	if _, err := ParseChecksumAddress("0x5AAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"); err != ErrAddressChecksum {
+		t.Errorf("got %v, wanted %v", err, ErrAddressChecksum)
+	}
+}
+
+func TestAddressString(t *testing.T) {
+	address, _ := ParseAddress("0x5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED")
+	if got, wanted := address.String(), "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"; got != wanted {
+		t.Errorf("got %v, wanted %v", got, wanted)
+	}
+}
+
+func TestAddressEqual(t *testing.T) {
+	lower, _ := ParseAddress(correctformataddress[0])
+	upper, _ := ParseAddress(correctformataddress[3])
+	if !lower.Equal(upper) || lower != upper {
+		t.Errorf("got %v, wanted %v", lower, upper)
+	}
+	if lower.IsZero() || !(Address{}).IsZero() {
+		t.Errorf("got %v, wanted %v", lower.IsZero(), false)
+	}
+
+	if !EqualAddresses(correctformataddress[0], correctformataddress[3]) {
+		t.Errorf("got %v, wanted %v", false, true)
+	}
+	if EqualAddresses(correctformataddress[0], "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed") {
+		t.Errorf("got %v, wanted %v", true, false)
+	}
+	if EqualAddresses("", correctformataddress[0]) || !EqualAddresses("", "") {
+		t.Errorf("got %v, wanted the invalid addresses compared as they are", EqualAddresses("", ""))
+	}
+}
+
+func TestBytesToAddress(t *testing.T) {
+	padded := LeftPadBytes(addressbytes, 32)
+	if got, wanted := BytesToAddress(padded).Bytes(), addressbytes; string(got) != string(wanted) {
+		t.Errorf("got %v, wanted %v", got, wanted)
+	}
+
+	got := BytesToAddress([]byte{1})
+	if got[19] != 1 || !BytesToAddress(got[:19]).IsZero() {
+		t.Errorf("got %v, wanted %v", got, "0x...01")
+	}
+}
+
+func TestAddressText(t *testing.T) {
+	var holder struct {
+		Token Address `json:"token"`
+	}
+
+	if err := json.Unmarshal([]byte(`{"token":"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"}`), &holder); err != nil {
+		t.Fatal(err)
+	}
+
+	got, _ := json.Marshal(holder)
+	if wanted := `{"token":"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"}`; string(got) != wanted {
+		t.Errorf("got %v, wanted %v", string(got), wanted)
+	}
+
+	for _, token := range []string{`"0x5aaeb6"`, `"a"`} {
+		if err := json.Unmarshal([]byte(`{"token":`+token+`}`), &holder); err == nil {
+			t.Errorf("got %v, wanted %v", err, ErrAddress)
+		}
+	}
+}
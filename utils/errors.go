@@ -4,8 +4,55 @@ import "errors"
 
 var ErrTokenAddress = errors.New("token address format is invalid or nil")
 var ErrToAddress = errors.New("recipient account address format is invalid or nil")
+var ErrAddressChecksum = errors.New("mixed-case address does not match its EIP-55 checksum")
 var ErrDecimalPoint = errors.New("decimal point must not be a negative number")
 var ErrValue = errors.New("value must be non-empty e.g. integer or decimal point number")
 var ErrDecimalValue = errors.New("the value must be given as an integer without a decimal point")
 var ErrSameEOAContractAddr = errors.New("externally onwed address (EOA) and contract address can never b same")
 var ErrAddressLength = errors.New("invalid address length; it must be 40 (without prefix 0x) or 42 (with prefix 0x)")
+var ErrTokenID = errors.New("token id must be non-empty and given as an integer without a decimal point")
+var ErrArrayLength = errors.New("token ids, values and accounts must be given in arrays of the same length")
+var ErrContractAddress = errors.New("contract address format is invalid or nil")
+var ErrMethodSignature = errors.New("method signature format is invalid e.g. it must be like transfer(address,uint256)")
+var ErrABIType = errors.New("abi type is invalid or not supported")
+var ErrArgumentCount = errors.New("number of arguments does not match the method signature")
+var ErrArgumentType = errors.New("argument cannot be encoded as the given abi type")
+var ErrReturnDataLength = errors.New("return data is truncated or its length is invalid")
+var ErrReturnDataFormat = errors.New("return data is malformed and cannot be decoded")
+var ErrABIFormat = errors.New("json abi format is invalid")
+var ErrMethodNotFound = errors.New("method is not defined in the abi")
+var ErrAmbiguousMethod = errors.New("arguments match more than one overloaded method; use the method signature instead")
+var ErrAddress = errors.New("address must be 20 bytes hex-encoded with prefix 0x")
+var ErrDecimalPrecision = errors.New("amount has more fractional digits than the decimals of its unit or token allow")
+var ErrUnknownDecimals = errors.New("decimals of the token are unknown; register them in ER20TokenDecimals")
+var ErrUnit = errors.New("unit is not defined e.g. it must be wei, gwei or ether")
+var ErrData = errors.New("data must be hex-encoded with or without prefix 0x")
+var ErrClause = errors.New("clause must not be nil")
+var ErrGasPrice = errors.New("gas price must be non-nil and must not be a negative number")
+var ErrGasLimit = errors.New("gas limit must be greater than zero")
+var ErrChainID = errors.New("chain id must not be a negative number")
+var ErrFeePerGas = errors.New("max fee and max priority fee per gas must be non-nil and the priority fee must not exceed the max fee")
+var ErrAccessList = errors.New("access list must hold valid addresses and storage keys of 32 bytes")
+var ErrRLP = errors.New("rlp encoding is malformed or not canonical")
+var ErrPrivateKey = errors.New("private key must be 32 bytes hex-encoded with or without prefix 0x")
+var ErrSignature = errors.New("signature of the transaction is invalid")
+var ErrTransactionType = errors.New("transaction type is not supported")
+var ErrDependsOn = errors.New("depends on must be a transaction id of 32 bytes hex-encoded with or without prefix 0x")
+var ErrDelegation = errors.New("delegated transaction must be signed by both origin and delegator, and only it has a delegator")
+var ErrClauseCount = errors.New("number of clauses exceeds the maximum of the chain")
+var ErrRPC = errors.New("json-rpc request to the node failed")
+var ErrBlockTag = errors.New("block tag must be latest, earliest, pending, safe, finalized or a hex-encoded block number")
+var ErrCallCount = errors.New("multicall must hold at least one call")
+var ErrPayoutRow = errors.New("payout row must hold an address and an amount")
+var ErrSelector = errors.New("method selector of the calldata is unknown")
+var ErrCalldata = errors.New("calldata length or format does not match the method")
+var ErrSelectorCollision = errors.New("signature shares its method selector with another registered signature")
+var ErrEventLog = errors.New("log does not match the event or its topics and data are malformed")
+var ErrLogFilter = errors.New("log filter must not be nil")
+var ErrValueOutOfRange = errors.New("value is out of range of its integer type, e.g. uint256")
+var ErrIntegerSize = errors.New("integer size must be a multiple of 8 bits from 8 to 256")
+var ErrMethod = errors.New("method is not defined by the token standard")
+var ErrPaymentRequest = errors.New("payment request must be an EIP-681 uri, e.g. ethereum:0x...@1/transfer?address=0x...&uint256=1e6")
+var ErrChainIDMismatch = errors.New("chain id of the payment request does not match the chain")
+var ErrUnsupportedFunction = errors.New("function of the payment request is not supported; only native payments and erc-20 transfer are")
+var ErrQRCodeSize = errors.New("qr code size must be a positive number of pixels")
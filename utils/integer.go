@@ -0,0 +1,115 @@
+package utils
+
+import (
+	"math/big"
+	"strconv"
+)
+
+// Integer represents the ABI integer of 8 to 256 bits, i.e., uint8..uint256 or
+// int8..int256. Its value is validated against the range of its type once
+// created, so it always fits in a word of 32 bytes.
+type Integer struct {
+	value  *big.Int
+	bits   int
+	signed bool
+}
+
+// NewInteger creates and returns an instance of Integer of the given value and
+// type, e.g. 256 bits unsigned for uint256. It returns ErrIntegerSize if the
+// size is not one of the ABI types and ErrValueOutOfRange if the value does not
+// fit in the type.
+func NewInteger(value *big.Int, bits int, signed bool) (*Integer, error) {
+	if bits < 8 || bits > 256 || bits%8 != 0 {
+		return nil, ErrIntegerSize
+	} else if value == nil || !isInRange(value, bits, signed) {
+		return nil, ErrValueOutOfRange
+	}
+	return &Integer{value: new(big.Int).Set(value), bits: bits, signed: signed}, nil
+}
+
+// NewUint256 creates and returns an instance of Integer of the uint256 type.
+func NewUint256(value *big.Int) (*Integer, error) {
+	return NewInteger(value, 256, false)
+}
+
+// ParseUint256 parses the given non-negative decimal integer, e.g. the value of
+// base units of a token, into an instance of Integer of the uint256 type. It
+// returns ErrValue if the value is not a decimal integer.
+func ParseUint256(value string) (*Integer, error) {
+	if !IsValidDecimalValue(value) {
+		return nil, ErrValue
+	}
+
+	integer, ok := new(big.Int).SetString(value, 10)
+	if !ok {
+		return nil, ErrValue
+	}
+	return NewUint256(integer)
+}
+
+// DecodeInteger decodes the given word of 32 bytes into an instance of Integer
+// of the given type. The signed value is decoded from its two's complement; the
+// word must be sign-extended, otherwise ErrValueOutOfRange is returned.
+func DecodeInteger(word []byte, bits int, signed bool) (*Integer, error) {
+	if len(word) != 32 {
+		return nil, ErrReturnDataLength
+	}
+
+	value := new(big.Int).SetBytes(word)
+	if signed && word[0]&0x80 != 0 {
+		value.Sub(value, twoTo256)
+	}
+	return NewInteger(value, bits, signed)
+}
+
+// twoTo256 holds 2^256, the modulus of the two's complement of a word.
+var twoTo256 = new(big.Int).Lsh(big.NewInt(1), 256)
+
+// isInRange validates the given integer against the range of an integer of the
+// given bits.
+func isInRange(integer *big.Int, bits int, signed bool) bool {
+	if !signed {
+		return integer.Sign() >= 0 && integer.BitLen() <= bits
+	}
+
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(bits-1))
+	return integer.Cmp(limit) < 0 && integer.Cmp(new(big.Int).Neg(limit)) >= 0
+}
+
+// Big returns a copy of the value of the integer.
+func (i *Integer) Big() *big.Int {
+	return new(big.Int).Set(i.value)
+}
+
+// Bits returns the number of bits of the integer type.
+func (i *Integer) Bits() int {
+	return i.bits
+}
+
+// Signed reports whether the integer type is signed.
+func (i *Integer) Signed() bool {
+	return i.signed
+}
+
+// Type returns the ABI type of the integer, e.g. uint256 or int8.
+func (i *Integer) Type() string {
+	if i.signed {
+		return "int" + strconv.Itoa(i.bits)
+	}
+	return "uint" + strconv.Itoa(i.bits)
+}
+
+// String returns the value of the integer in decimal.
+func (i *Integer) String() string {
+	return i.value.String()
+}
+
+// Word returns the ABI encoding of the integer; the word of 32 bytes, where the
+// negative value is encoded in two's complement.
+func (i *Integer) Word() []byte {
+	value := i.value
+	if value.Sign() < 0 {
+		value = new(big.Int).Add(value, twoTo256)
+	}
+	return LeftPadBytes(value.Bytes(), 32)
+}
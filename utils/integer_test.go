@@ -0,0 +1,91 @@
+package utils
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+var maxUint256 string = "115792089237316195423570985008687907853269984665640564039457584007913129639935"
+
+func TestParseUint256(t *testing.T) {
+	integer, err := ParseUint256(maxUint256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, wanted := hex.EncodeToString(integer.Word()), strings.Repeat("f", 64); got != wanted {
+		t.Errorf("got %v, wanted %v", got, wanted)
+	}
+	if got, wanted := integer.Type(), "uint256"; got != wanted {
+		t.Errorf("got %v, wanted %v", got, wanted)
+	}
+
+	// 2^256 does not fit in a word.
+	if _, err := ParseUint256(maxUint256[:len(maxUint256)-1] + "6"); err != ErrValueOutOfRange {
+		t.Errorf("got %v, wanted %v", err, ErrValueOutOfRange)
+	}
+	for _, value := range []string{"", "-1", "1.5", "0x10"} {
+		if _, err := ParseUint256(value); err != ErrValue {
+			t.Errorf("got %v, wanted %v", err, ErrValue)
+		}
+	}
+}
+
+func TestNewInteger(t *testing.T) {
+	tests := []struct {
+		value  int64
+		bits   int
+		signed bool
+		err    error
+	}{
+		{255, 8, false, nil},
+		{256, 8, false, ErrValueOutOfRange},
+		{-1, 8, false, ErrValueOutOfRange},
+		{127, 8, true, nil},
+		{128, 8, true, ErrValueOutOfRange},
+		{-128, 8, true, nil},
+		{-129, 8, true, ErrValueOutOfRange},
+		{1, 0, false, ErrIntegerSize},
+		{1, 12, false, ErrIntegerSize},
+		{1, 264, true, ErrIntegerSize},
+	}
+
+	for _, test := range tests {
+		if _, err := NewInteger(big.NewInt(test.value), test.bits, test.signed); err != test.err {
+			t.Errorf("got %v, wanted %v for %d of %d bits", err, test.err, test.value, test.bits)
+		}
+	}
+
+	if _, err := NewUint256(nil); err != ErrValueOutOfRange {
+		t.Errorf("got %v, wanted %v", err, ErrValueOutOfRange)
+	}
+}
+
+func TestIntegerWord(t *testing.T) {
+	integer, _ := NewInteger(big.NewInt(-2), 16, true)
+	word := integer.Word()
+	if got, wanted := hex.EncodeToString(word), strings.Repeat("f", 63)+"e"; got != wanted {
+		t.Errorf("got %v, wanted %v", got, wanted)
+	}
+	if got, wanted := integer.Type(), "int16"; got != wanted {
+		t.Errorf("got %v, wanted %v", got, wanted)
+	}
+
+	decoded, err := DecodeInteger(word, 16, true)
+	if err != nil || decoded.Big().Int64() != -2 {
+		t.Errorf("got %v, wanted %v: %v", decoded, -2, err)
+	}
+
+	// the same word is out of range of uint16, and -2^15-1 of int16.
+	if _, err := DecodeInteger(word, 16, false); err != ErrValueOutOfRange {
+		t.Errorf("got %v, wanted %v", err, ErrValueOutOfRange)
+	}
+	word[30] = 0x7f
+	if _, err := DecodeInteger(word, 16, true); err != ErrValueOutOfRange {
+		t.Errorf("got %v, wanted %v", err, ErrValueOutOfRange)
+	}
+	if _, err := DecodeInteger(word[1:], 16, true); err != ErrReturnDataLength {
+		t.Errorf("got %v, wanted %v", err, ErrReturnDataLength)
+	}
+}
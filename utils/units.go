@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"math/big"
+	"strings"
+)
+
+// ParseUnits converts the given human-readable amount, e.g. "12.345", into
+// the exact integer amount of base units using the given decimals. It returns
+// an error if the amount has more fractional digits than the decimals allow.
+func ParseUnits(amount string, decimals uint8) (*big.Int, error) {
+	if !IsValidValue(amount) || amount == "." {
+		return nil, ErrValue
+	}
+
+	integer, fraction := amount, ""
+	if i := strings.Index(amount, "."); i >= 0 {
+		integer, fraction = amount[:i], amount[i+1:]
+	}
+
+	// trailing zeros carry no precision, e.g. "1.50" with one decimal.
+	fraction = strings.TrimRight(fraction, "0")
+	if len(fraction) > int(decimals) {
+		return nil, ErrDecimalPrecision
+	}
+
+	digits := integer + fraction + strings.Repeat("0", int(decimals)-len(fraction))
+	value, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return nil, ErrValue
+	}
+	return value, nil
+}
+
+// FormatUnits converts the given integer amount of base units into its exact
+// human-readable amount using the given decimals, e.g. 12345 with 3 decimals
+// into "12.345". Trailing zeros of the fraction are removed.
+func FormatUnits(value *big.Int, decimals uint8) string {
+	digits := new(big.Int).Abs(value).String()
+	if len(digits) <= int(decimals) {
+		digits = strings.Repeat("0", int(decimals)-len(digits)+1) + digits
+	}
+
+	point := len(digits) - int(decimals)
+	integer, fraction := digits[:point], strings.TrimRight(digits[point:], "0")
+
+	formatted := integer
+	if fraction != "" {
+		formatted += "." + fraction
+	}
+	if value.Sign() < 0 {
+		formatted = "-" + formatted
+	}
+	return formatted
+}
@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestParseUnits(t *testing.T) {
+	tests := []struct {
+		amount   string
+		decimals uint8
+		expected string
+	}{
+		{"12.345", 18, "12345000000000000000"},
+		{"12.345", 3, "12345"},
+		{"1.50", 1, "15"},
+		{"0.000001", 6, "1"},
+		{"00.5", 2, "50"},
+		{"7", 0, "7"},
+		{"5.", 2, "500"},
+		{".5", 2, "50"},
+	}
+
+	for _, test := range tests {
+		value, err := ParseUnits(test.amount, test.decimals)
+		if err != nil {
+			t.Errorf("cannot parse %s: %v", test.amount, err)
+			continue
+		}
+
+		if value.String() != test.expected {
+			t.Errorf("got %v, wanted %v", value, test.expected)
+		}
+	}
+}
+
+func TestParseUnitsInvalid(t *testing.T) {
+	tests := []struct {
+		amount   string
+		decimals uint8
+		err      error
+	}{
+		{"12.345", 2, ErrDecimalPrecision},
+		{"0.5", 0, ErrDecimalPrecision},
+		{"", 18, ErrValue},
+		{".", 18, ErrValue},
+		{"1.2.3", 18, ErrValue},
+		{"-1", 18, ErrValue},
+		{"1e18", 18, ErrValue},
+	}
+
+	for _, test := range tests {
+		_, err := ParseUnits(test.amount, test.decimals)
+		if err != test.err {
+			t.Errorf("%s: got %v, wanted %v", test.amount, err, test.err)
+		}
+	}
+}
+
+func TestFormatUnits(t *testing.T) {
+	tests := []struct {
+		value    int64
+		decimals uint8
+		expected string
+	}{
+		{12345, 3, "12.345"},
+		{12000, 3, "12"},
+		{1, 6, "0.000001"},
+		{0, 18, "0"},
+		{-15, 1, "-1.5"},
+		{7, 0, "7"},
+	}
+
+	for _, test := range tests {
+		formatted := FormatUnits(big.NewInt(test.value), test.decimals)
+		if formatted != test.expected {
+			t.Errorf("got %v, wanted %v", formatted, test.expected)
+		}
+	}
+}
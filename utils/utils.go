@@ -2,13 +2,16 @@ package utils
 
 import (
 	"encoding/hex"
-	"errors"
 	"regexp"
 	"strings"
+
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/sha3"
 )
 
 // AddresstoBytes converts the given Ethereum-based account address.
-// It returns an error if the given address is invalid.
+// It returns ErrAddressLength if the length of the given address is invalid,
+// and ErrAddress if it is not hex-encoded.
 func AddresstoBytes(address string) ([]byte, error) {
 	var data []byte
 	var err error
@@ -17,17 +20,14 @@ func AddresstoBytes(address string) ([]byte, error) {
 	if addrlen == 42 || addrlen == 40 {
 		if address[0] == '0' && (address[1] == 'x' || address[1] == 'X') {
 			data, err = hex.DecodeString(address[2:])
-			if err != nil {
-				return nil, err
-			}
 		} else {
 			data, err = hex.DecodeString(address)
-			if err != nil {
-				return nil, err
-			}
+		}
+		if err != nil || len(data) != 20 {
+			return nil, ErrAddress
 		}
 	} else {
-		return nil, errors.New("invalid address length")
+		return nil, ErrAddressLength
 	}
 	return data, nil
 }
@@ -35,7 +35,7 @@ func AddresstoBytes(address string) ([]byte, error) {
 // IsValidAddress validates the given Ethereum-based account address. It
 // returns true if the address format is valid, and otherwise returns false.
 func IsValidAddress(address string) bool {
-	if address != "" && (address[:2] == "0x" || address[:2] == "0X") {
+	if strings.HasPrefix(address, "0x") || strings.HasPrefix(address, "0X") {
 		address = address[2:]
 	} else {
 		return false
@@ -46,6 +46,47 @@ func IsValidAddress(address string) bool {
 	return isValidaddr
 }
 
+// ToChecksumAddress converts the given Ethereum-based account address into
+// its EIP-55 mixed-case checksum encoding. It returns ErrAddressLength if the
+// length of the given address is not 42, and ErrAddress if it is not
+// hex-encoded with prefix 0x.
+func ToChecksumAddress(address string) (string, error) {
+	if len(address) != 42 {
+		return "", ErrAddressLength
+	} else if !IsValidAddress(address) {
+		return "", ErrAddress
+	}
+
+	lower := strings.ToLower(address[2:])
+	hash := hex.EncodeToString(Keccak256([]byte(lower)))
+
+	checksum := []byte(lower)
+	for i, char := range checksum {
+		if char >= 'a' && char <= 'f' && hash[i] >= '8' {
+			checksum[i] = char - 'a' + 'A'
+		}
+	}
+	return "0x" + string(checksum), nil
+}
+
+// IsValidChecksumAddress validates the given Ethereum-based account address
+// including its EIP-55 checksum. The address given in all lower-case or all
+// upper-case carries no checksum and is accepted as long as its format is
+// valid; the mixed-case address must match its checksum encoding.
+func IsValidChecksumAddress(address string) bool {
+	if !IsValidAddress(address) {
+		return false
+	}
+
+	hexaddr := address[2:]
+	if hexaddr == strings.ToLower(hexaddr) || hexaddr == strings.ToUpper(hexaddr) {
+		return true
+	}
+
+	checksum, err := ToChecksumAddress(address)
+	return err == nil && checksum[2:] == hexaddr
+}
+
 // IsValidDecimalValue validates only integer value
 // (non decimal point).
 func IsValidDecimalValue(str string) bool {
@@ -76,6 +117,33 @@ func IsValidValue(str string) bool {
 	return true
 }
 
+// Keccak256 calculates and returns the keccak-256 hash of the given data.
+func Keccak256(data ...[]byte) []byte {
+	hash := sha3.NewLegacyKeccak256()
+	for _, d := range data {
+		hash.Write(d)
+	}
+	return hash.Sum(nil)
+}
+
+// Blake2b256 calculates and returns the blake2b-256 hash of the given data, as
+// used by VeChainThor.
+func Blake2b256(data ...[]byte) []byte {
+	hash, _ := blake2b.New256(nil)
+	for _, d := range data {
+		hash.Write(d)
+	}
+	return hash.Sum(nil)
+}
+
+// MethodID calculates and returns the method ID of 4 bytes, i.e., the
+// first four bytes of the keccak-256 hash of the given method signature.
+func MethodID(method string) [4]byte {
+	var methodSignature [4]byte
+	copy(methodSignature[:], Keccak256([]byte(method))[:4])
+	return methodSignature
+}
+
 // leftPadBytes places the number of zeros to left side
 // according to the given length.
 func LeftPadBytes(data []byte, length int) []byte {
@@ -87,3 +155,15 @@ func LeftPadBytes(data []byte, length int) []byte {
 	copy(paddbytes[length-len(data):], data)
 	return paddbytes
 }
+
+// RightPadBytes places the number of zeros to right side
+// according to the given length.
+func RightPadBytes(data []byte, length int) []byte {
+	if length <= len(data) {
+		return data
+	}
+
+	paddbytes := make([]byte, length)
+	copy(paddbytes, data)
+	return paddbytes
+}
@@ -2,6 +2,8 @@ package utils
 
 import (
 	"bytes"
+	"encoding/hex"
+	"strings"
 	"testing"
 )
 
@@ -56,6 +58,13 @@ func TestAddresstoBytes(t *testing.T) {
 		}
 	}
 
+	if _, err := AddresstoBytes("0x3"); err != ErrAddressLength {
+		t.Errorf("got %v, wanted %v", err, ErrAddressLength)
+	}
+	if _, err := AddresstoBytes("0xdj2890587cfada7fec247c5180d73de6c670c4"); err != ErrAddress {
+		t.Errorf("got %v, wanted %v", err, ErrAddress)
+	}
+
 	for _, correctvalue := range correctformataddress {
 		var isvalid, expected bool
 		convaddressbytes, err := AddresstoBytes(correctvalue)
@@ -143,3 +152,104 @@ func TestLeftPadBytes(t *testing.T) {
 		t.Errorf("got %v, wanted %v", isvalid, expected)
 	}
 }
+
+func TestMethodID(t *testing.T) {
+	methodIDs := map[string]string{
+		"transfer(address,uint256)":                       "a9059cbb",
+		"ownerOf(uint256)":                                "6352211e",
+		"safeTransferFrom(address,address,uint256,bytes)": "b88d4fde",
+	}
+
+	for method, expected := range methodIDs {
+		id := MethodID(method)
+		methodId := hex.EncodeToString(id[:])
+		if methodId != expected {
+			t.Errorf("got %v, wanted %v", methodId, expected)
+		}
+	}
+}
+
+func TestBlake2b256(t *testing.T) {
+	hashes := map[string]string{
+		"":    "0e5751c026e543b2e8ab2eb06099daa1d1e5df47778f7787faab45cdf12fe3a8",
+		"abc": "bddd813c634239723171ef3fee98579b94964e3bb1cb3e427262c8c068d52319",
+	}
+
+	for data, expected := range hashes {
+		hash := hex.EncodeToString(Blake2b256([]byte(data)))
+		if hash != expected {
+			t.Errorf("got %v, wanted %v", hash, expected)
+		}
+	}
+}
+
+func TestRightPadBytes(t *testing.T) {
+	resultbytes := RightPadBytes([]byte{1, 2, 3}, 32)
+	expected := append([]byte{1, 2, 3}, make([]byte, 29)...)
+	if bytes.Compare(resultbytes, expected) != 0 {
+		t.Errorf("got %v, wanted %v", resultbytes, expected)
+	}
+
+	resultbytes = RightPadBytes(addressbytes, 4)
+	if bytes.Compare(resultbytes, addressbytes) != 0 {
+		t.Errorf("got %v, wanted %v", resultbytes, addressbytes)
+	}
+}
+
+var checksumaddress = []string{
+	"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+	"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+	"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB",
+	"0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb",
+}
+
+func TestToChecksumAddress(t *testing.T) {
+	for _, expected := range checksumaddress {
+		for _, address := range []string{strings.ToLower(expected), "0x" + strings.ToUpper(expected[2:])} {
+			checksum, err := ToChecksumAddress(address)
+			if err != nil {
+				t.Errorf("cannot convert address: %v", err)
+			}
+
+			if checksum != expected {
+				t.Errorf("got %v, wanted %v", checksum, expected)
+			}
+		}
+	}
+
+	for _, wrongvalue := range wrongformataddress {
+		if _, err := ToChecksumAddress(wrongvalue); err == nil {
+			t.Errorf("got %v, wanted an error for %s", err, wrongvalue)
+		}
+	}
+
+	if _, err := ToChecksumAddress("0x3"); err != ErrAddressLength {
+		t.Errorf("got %v, wanted %v", err, ErrAddressLength)
+	}
+	if _, err := ToChecksumAddress("0xdj228890587cfada7fec247c5180d73de6c670c4"); err != ErrAddress {
+		t.Errorf("got %v, wanted %v", err, ErrAddress)
+	}
+}
+
+func TestIsValidChecksumAddress(t *testing.T) {
+	for _, correctvalue := range append(checksumaddress, correctformataddress...) {
+		isvalid := IsValidChecksumAddress(correctvalue)
+		expected := true
+		if isvalid != expected {
+			t.Errorf("got %v, wanted %v", isvalid, expected)
+		}
+	}
+
+	wrongchecksumaddress := []string{
+		"0x5aaeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d35A",
+		"0xf6fe970533fe5c63d196139B14522Eb2956f8621",
+	}
+	for _, wrongvalue := range append(wrongchecksumaddress, wrongformataddress...) {
+		isvalid := IsValidChecksumAddress(wrongvalue)
+		expected := false
+		if isvalid != expected {
+			t.Errorf("got %v, wanted %v", isvalid, expected)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Fields of the builders reported by ValidationError.
+const (
+	FieldTo           = "to"
+	FieldValue        = "value"
+	FieldTokenAddress = "tokenAddress"
+	FieldData         = "data"
+	FieldMethod       = "method"
+	FieldTokenID      = "tokenID"
+	FieldAccounts     = "accounts"
+	FieldRow          = "row"
+	FieldChainID      = "chainID"
+	FieldGasPrice     = "gasPrice"
+	FieldClause       = "clause"
+)
+
+// Machine-readable codes of ValidationError. They are stable, unlike the
+// messages of the errors.
+const (
+	CodeInvalid          = "invalid"
+	CodeInvalidAddress   = "invalid_address"
+	CodeAddressChecksum  = "address_checksum"
+	CodeSameAddress      = "same_address"
+	CodeInvalidValue     = "invalid_value"
+	CodeValueOutOfRange  = "value_out_of_range"
+	CodeDecimalPrecision = "decimal_precision"
+	CodeUnknownDecimals  = "unknown_decimals"
+	CodeUnknownMethod    = "unknown_method"
+	CodeArrayLength      = "array_length"
+	CodeUnsupported      = "unsupported"
+)
+
+// validationCodes holds the code of each sentinel error.
+var validationCodes = map[error]string{
+	ErrTokenAddress:        CodeInvalidAddress,
+	ErrToAddress:           CodeInvalidAddress,
+	ErrContractAddress:     CodeInvalidAddress,
+	ErrAddressLength:       CodeInvalidAddress,
+	ErrAddress:             CodeInvalidAddress,
+	ErrAddressChecksum:     CodeAddressChecksum,
+	ErrSameEOAContractAddr: CodeSameAddress,
+	ErrValue:               CodeInvalidValue,
+	ErrDecimalValue:        CodeInvalidValue,
+	ErrValueOutOfRange:     CodeValueOutOfRange,
+	ErrDecimalPrecision:    CodeDecimalPrecision,
+	ErrUnknownDecimals:     CodeUnknownDecimals,
+	ErrMethod:              CodeUnknownMethod,
+	ErrMethodNotFound:      CodeUnknownMethod,
+	ErrTokenID:             CodeInvalidValue,
+	ErrArrayLength:         CodeArrayLength,
+	ErrChainID:             CodeInvalidValue,
+	ErrGasPrice:            CodeInvalidValue,
+	ErrUnsupportedFunction: CodeUnsupported,
+}
+
+// ValidationError represents the failed validation of a single field of a
+// builder: the field, the offending input, the code and the sentinel error,
+// which errors.Is matches.
+type ValidationError struct {
+	Field string
+	Input string
+	Code  string
+	Err   error
+}
+
+// NewValidationError creates and returns an instance of ValidationError of the
+// given field, input and sentinel error, along with the code of the error.
+func NewValidationError(field, input string, err error) *ValidationError {
+	code, ok := validationCodes[err]
+	if !ok {
+		code = CodeInvalid
+	}
+	return &ValidationError{Field: field, Input: input, Code: code, Err: err}
+}
+
+// Error returns the field and the input along with the error.
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s %q: %v", e.Field, e.Input, e.Err)
+}
+
+// Unwrap returns the sentinel error.
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationErrors holds the failed validations of all the fields of a builder.
+type ValidationErrors []*ValidationError
+
+// Error returns the errors of the fields separated by semicolons.
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Is reports whether any of the errors matches the given target, so that
+// errors.Is finds the sentinel error of every field.
+func (e ValidationErrors) Is(target error) bool {
+	for _, err := range e {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first of the errors that matches the given target, so that
+// errors.As extracts the *ValidationError of the first failed field.
+func (e ValidationErrors) As(target interface{}) bool {
+	for _, err := range e {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// Field returns the error of the given field, or nil if it is valid.
+func (e ValidationErrors) Field(field string) *ValidationError {
+	for _, err := range e {
+		if err.Field == field {
+			return err
+		}
+	}
+	return nil
+}
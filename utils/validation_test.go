@@ -0,0 +1,59 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestValidationError(t *testing.T) {
+	err := NewValidationError(FieldTo, "0x3", ErrToAddress)
+	if got, wanted := err.Code, CodeInvalidAddress; got != wanted {
+		t.Errorf("got %v, wanted %v", got, wanted)
+	}
+	if got, wanted := err.Error(), `to "0x3": `+ErrToAddress.Error(); got != wanted {
+		t.Errorf("got %v, wanted %v", got, wanted)
+	}
+	if !errors.Is(fmt.Errorf("wrapped: %w", err), ErrToAddress) {
+		t.Errorf("got %v, wanted %v", err, ErrToAddress)
+	}
+
+	if got := NewValidationError(FieldData, "", ErrData).Code; got != CodeInvalid {
+		t.Errorf("got %v, wanted %v", got, CodeInvalid)
+	}
+}
+
+func TestValidationErrors(t *testing.T) {
+	var err error = ValidationErrors{
+		NewValidationError(FieldTokenAddress, "", ErrTokenAddress),
+		NewValidationError(FieldValue, "1.5", ErrValue),
+	}
+
+	if !errors.Is(err, ErrTokenAddress) || !errors.Is(err, ErrValue) {
+		t.Errorf("got %v, wanted both %v and %v", err, ErrTokenAddress, ErrValue)
+	}
+	if errors.Is(err, ErrToAddress) {
+		t.Errorf("got %v, wanted no %v", err, ErrToAddress)
+	}
+
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) || validationErr.Field != FieldTokenAddress {
+		t.Errorf("got %v, wanted the error of %v", validationErr, FieldTokenAddress)
+	}
+
+	var validationErrs ValidationErrors
+	if !errors.As(err, &validationErrs) || len(validationErrs) != 2 {
+		t.Fatalf("got %v, wanted %v errors", validationErrs, 2)
+	}
+	if got := validationErrs.Field(FieldValue); got == nil || got.Input != "1.5" {
+		t.Errorf("got %v, wanted the error of %v", got, FieldValue)
+	}
+	if got := validationErrs.Field(FieldTo); got != nil {
+		t.Errorf("got %v, wanted %v", got, nil)
+	}
+
+	wanted := `tokenAddress "": ` + ErrTokenAddress.Error() + `; value "1.5": ` + ErrValue.Error()
+	if err.Error() != wanted {
+		t.Errorf("got %v, wanted %v", err.Error(), wanted)
+	}
+}